@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"MandatoryActivity5/history"
+)
+
+// Checker reads histories recorded by Client.go with -history and reports
+// whether together they are linearizable.
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("Usage: %s <history file>...", os.Args[0])
+	}
+
+	var ops []history.Operation
+	for _, path := range os.Args[1:] {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("failed to open history: %v", err)
+		}
+		fileOps, err := history.Read(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("failed to read %s: %v", path, err)
+		}
+		ops = append(ops, fileOps...)
+	}
+
+	if err := history.Check(ops); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Printf("history of %d operations is linearizable\n", len(ops))
+}
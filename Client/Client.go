@@ -2,90 +2,461 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
+	"log/slog"
 	"math/rand"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+	"MandatoryActivity5/history"
+	"MandatoryActivity5/load"
+	"MandatoryActivity5/logging"
+	"MandatoryActivity5/shiviz"
+	"MandatoryActivity5/tracing"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func main() {
-	// Set up logging to a file
-	logFile, logErr := os.OpenFile("log.txt", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
-	if logErr != nil {
-		log.Fatalf("failed to open log file: %v", logErr)
+	shivizPath := flag.String("shiviz", "", "also write a ShiViz vector clock log of every call to this file")
+	historyPath := flag.String("history", "", "record every Bid and Result in this file for the linearizability checker")
+	nodesFlag := flag.String("nodes", "localhost:50051,localhost:50052,localhost:50053", "comma-separated addresses of the nodes")
+	auctionID := flag.String("auction", "", "ID of the auction to bid in; empty for the default auction")
+	create := flag.Bool("create", false, "create the auction given by -auction before bidding")
+	startFlag := flag.String("start", "", "with -create, when the auction opens, in RFC 3339 format; empty for now")
+	duration := flag.Duration("duration", 100*time.Second, "with -create, how long the auction is open")
+	opening := flag.Int("opening", 0, "with -create, the lowest first bid")
+	reserve := flag.Int("reserve", 0, "with -create, the lowest bid that wins")
+	mode := flag.String("mode", "english", "with -create, the kind of auction: english, sealed_first_price, vickrey or dutch")
+	decrement := flag.Int("decrement", 0, "with -create -mode dutch, how much the price drops every -decrement-interval")
+	decrementInterval := flag.Duration("decrement-interval", 5*time.Second, "with -create -mode dutch, how often the price drops")
+	increments := flag.String("increments", "", "with -create, minimum raises by price band, as from:step pairs, for example 0:1,100:5,1000:25")
+	softCloseWindow := flag.Duration("soft-close-window", 0, "with -create, a bid this close to the end extends the auction; 0 for a fixed end")
+	softCloseExtension := flag.Duration("soft-close-extension", 10*time.Second, "with -create -soft-close-window, how long after such a bid the auction ends at the earliest")
+	traceDest := flag.String("trace", "", "export traces to stdout, or to the OTLP collector at this address; empty for none")
+	logConfig := logging.Flags("client.log", "file to write JSON logs to")
+	list := flag.Bool("list", false, "print the bid history of the auction given by -auction and exit")
+	biddersFlag := flag.Int("bidders", 2, "number of bidders to run at once")
+	rate := flag.Float64("rate", 0, "rounds of a Result and maybe a Bid per second, over all bidders; 0 for each bidder to wait 1 to 5 seconds at random between rounds")
+	runFor := flag.Duration("for", 0, "stop bidding after this long, even if the auction is still open; 0 to bid until it closes")
+	maxBid := flag.Int("max", 0, "in an English auction, let the nodes bid automatically for each bidder up to a random maximum of at most this; 0 to bid by hand")
+	adminCmd := flag.String("admin", "", "as the auctioneer, close or cancel the auction given by -auction, or retract the bid given by -bid from it, and exit")
+	adminToken := flag.String("admin-token", "", "with -admin or -list, the admin token of the nodes; empty for $AUCTION_ADMIN_TOKEN")
+	bidID := flag.String("bid", "", "with -admin retract, the ID of the bid, as -list prints it")
+	reason := flag.String("reason", "", "with -admin, why the auctioneer intervenes")
+	flag.Parse()
+	if *adminToken == "" {
+		*adminToken = os.Getenv("AUCTION_ADMIN_TOKEN")
 	}
+
+	// Set up logging to a file
+	logger, logFile := logConfig.Open()
 	defer logFile.Close()
-	log.SetOutput(logFile)
+	slog.SetDefault(logger)
 
-	nodes := []string{"localhost:50051", "localhost:50052", "localhost:50053"}
+	var shivizLog *shiviz.Log
+	if *shivizPath != "" {
+		var err error
+		shivizLog, err = shiviz.Create(*shivizPath)
+		if err != nil {
+			log.Fatalf("failed to open ShiViz log: %v", err)
+		}
+		defer shivizLog.Close()
+	}
 
-	var wg sync.WaitGroup
-	bidders := []string{"Alice", "Bob"}
+	var recorder *history.Recorder
+	if *historyPath != "" {
+		historyFile, err := os.Create(*historyPath)
+		if err != nil {
+			log.Fatalf("failed to create history file: %v", err)
+		}
+		defer historyFile.Close()
+		recorder = history.NewRecorder(historyFile, clock.Real)
+	}
+
+	if *traceDest != "" {
+		stop, err := tracing.Start(context.Background(), "client", *traceDest)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer stop(context.Background())
+	}
+
+	nodes := strings.Split(*nodesFlag, ",")
+
+	if *list {
+		if err := listBids(nodes[rand.Intn(len(nodes))], *auctionID, *adminToken); err != nil {
+			log.Fatalf("could not list bids: %v", err)
+		}
+		return
+	}
+	if *adminCmd != "" {
+		if err := admin(nodes[rand.Intn(len(nodes))], *adminCmd, *auctionID, *bidID, *reason, *adminToken); err != nil {
+			log.Fatalf("could not %s: %v", *adminCmd, err)
+		}
+		return
+	}
+
+	// Bid until the default auction is over, or until the one we create is.
+	end := clock.Real.Now().Add(100 * time.Second)
+	if *create {
+		start := clock.Real.Now()
+		if *startFlag != "" {
+			var err error
+			if start, err = time.Parse(time.RFC3339, *startFlag); err != nil {
+				log.Fatalf("invalid start time: %v", err)
+			}
+		}
+		req := &pb.CreateAuctionRequest{
+			Auction: *auctionID,
+			Start:   timestamppb.New(start),
+			Close:   &pb.CreateAuctionRequest_Duration{Duration: durationpb.New(*duration)},
+			Opening: int32(*opening),
+			Reserve: int32(*reserve),
+		}
+		if *decrement > 0 {
+			req.Decrement = int32(*decrement)
+			req.DecrementInterval = durationpb.New(*decrementInterval)
+		}
+		if *softCloseWindow > 0 {
+			req.SoftCloseWindow = durationpb.New(*softCloseWindow)
+			req.SoftCloseExtension = durationpb.New(*softCloseExtension)
+		}
+		if m, ok := pb.AuctionMode_value[strings.ToUpper(*mode)]; ok {
+			req.Mode = pb.AuctionMode(m)
+		} else {
+			log.Fatalf("unknown auction mode %q", *mode)
+		}
+		if *increments != "" {
+			for _, band := range strings.Split(*increments, ",") {
+				var inc pb.Increment
+				if _, err := fmt.Sscanf(band, "%d:%d", &inc.From, &inc.Step); err != nil {
+					log.Fatalf("invalid increment %q: %v", band, err)
+				}
+				req.Increments = append(req.Increments, &inc)
+			}
+		}
+		if err := createAuction(nodes[rand.Intn(len(nodes))], req, recorder); err != nil {
+			log.Fatalf("could not create auction: %v", err)
+		}
+		end = start.Add(*duration)
+	}
 
-	for _, bidder := range bidders {
+	// Bid until the auction is over, -for has passed or we are
+	// interrupted, then report on the calls made.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if *runFor > 0 {
+		// Cancel rather than time out, so that the calls cut short are not
+		// counted as failed.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer time.AfterFunc(*runFor, cancel).Stop()
+	}
+	var interval time.Duration
+	if *rate > 0 {
+		interval = time.Duration(float64(*biddersFlag) / *rate * float64(time.Second))
+	}
+	stats := load.New()
+
+	var wg sync.WaitGroup
+	for i := range *biddersFlag {
+		bidder := bidderName(i)
 		wg.Add(1)
-		go func(bidder string) {
+		go func() {
 			defer wg.Done()
-			for {
-				select {
-				case <-time.After(100 * time.Second):
-					return
-				default:
-					// Random delay between 1 and 5 seconds
-					time.Sleep(time.Duration(rand.Intn(5)+1) * time.Second)
-
-					// Randomly select a node
-					node := nodes[rand.Intn(len(nodes))]
-					conn, err := grpc.Dial(node, grpc.WithInsecure(), grpc.WithBlock())
-					if err != nil {
-						log.Printf("Failed to connect to %s: %v", node, err)
-						continue
-					}
-					defer conn.Close()
-					c := pb.NewAuctionClient(conn)
-
-					ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-					defer cancel()
-
-					// Get the current highest bid
-					resultResp, err := c.Result(ctx, &pb.ResultRequest{})
-					if err != nil {
-						log.Printf("could not get result: %v", err)
-						continue
-					}
-
-					highestBid := resultResp.GetHighestbid()
-
-					// Check if the auction is over
-					if strings.HasPrefix(highestBid, "Auction over.") {
-						log.Printf("Auction result: %s", highestBid)
-						return
-					}
-
-					// Place a new bid higher than the current highest bid
-					currentHighestBid, err := strconv.Atoi(highestBid)
-					if err != nil {
-						log.Printf("could not convert highest bid to int: %v", err)
-						continue
-					}
-					newBidAmount := currentHighestBid + 1
-					bidResp, err := c.Bid(ctx, &pb.BidRequest{Bidder: bidder, Amount: int32(newBidAmount)})
-					if err != nil {
-						log.Printf("could not bid: %v", err)
-						continue
-					}
-					log.Printf("Bidder %s bid %d on node %s: %s", bidder, newBidAmount, node, bidResp.Message)
-				}
+			dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), tracing.DialOption(),
+				grpc.WithChainUnaryInterceptor(logging.UnaryClientInterceptor(), stats.UnaryClientInterceptor())}
+			if shivizLog != nil {
+				// Each bidder is its own process in the visualization.
+				process := shivizLog.Process(bidder)
+				dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(process.UnaryClientInterceptor()))
 			}
-		}(bidder)
+			runBidder(ctx, clock.Real, bidder, *auctionID, end, interval, int32(*maxBid), nodes, dialOpts, recorder)
+		}()
 	}
 
 	wg.Wait()
+	stats.Report(os.Stdout)
+}
+
+// names are the names of the first bidders.
+var names = []string{"Alice", "Bob", "Carol", "Dave", "Erin", "Frank", "Grace", "Heidi"}
+
+// bidderName returns the name of bidder i, counting from 0.
+func bidderName(i int) string {
+	if i < len(names) {
+		return names[i]
+	}
+	return fmt.Sprintf("Bidder%d", i+1)
+}
+
+// createAuction sends req to node, recording it with recorder.
+func createAuction(node string, req *pb.CreateAuctionRequest, recorder *history.Recorder) error {
+	conn, err := grpc.NewClient(node, grpc.WithTransportCredentials(insecure.NewCredentials()), tracing.DialOption(), grpc.WithUnaryInterceptor(logging.UnaryClientInterceptor()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = recorder.CreateAuction(ctx, pb.NewAuctionClient(conn), "auctioneer", req)
+	return err
+}
+
+// listBids prints the bid history of auctionID, as node has it, page by
+// page. With the admin token, sealed bids are listed before the auction
+// closes.
+func listBids(node, auctionID, token string) error {
+	conn, err := grpc.NewClient(node, grpc.WithTransportCredentials(insecure.NewCredentials()), tracing.DialOption(), grpc.WithUnaryInterceptor(logging.UnaryClientInterceptor()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewAuctionClient(conn)
+
+	req := &pb.ListBidsRequest{Auction: auctionID}
+	for {
+		ctx, cancel := context.WithTimeout(adminContext(token), 5*time.Second)
+		resp, err := c.ListBids(ctx, req)
+		cancel()
+		if err != nil {
+			return err
+		}
+		for _, b := range resp.Bids {
+			kind := "bid"
+			if b.Automatic {
+				kind = "maximum"
+			}
+			fmt.Printf("%s  %-26s node %d  %-10s %-8s %6d  %s %s\n", time.Unix(0, b.Timestamp.GetWall()).Format(time.RFC3339Nano), b.Id, b.Node, b.Bidder, kind, b.Amount, b.Outcome, b.Reason)
+		}
+		if resp.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// adminContext returns a context carrying token for the Admin service, if
+// there is one.
+func adminContext(token string) context.Context {
+	ctx := context.Background()
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// admin sends the Admin command cmd, close, cancel or retract, for
+// auctionID to node.
+func admin(node, cmd, auctionID, bidID, reason, token string) error {
+	conn, err := grpc.NewClient(node, grpc.WithTransportCredentials(insecure.NewCredentials()), tracing.DialOption(), grpc.WithUnaryInterceptor(logging.UnaryClientInterceptor()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	c := pb.NewAdminClient(conn)
+
+	ctx, cancel := context.WithTimeout(adminContext(token), 5*time.Second)
+	defer cancel()
+	var resp *pb.AdminResponse
+	switch cmd {
+	case "close":
+		resp, err = c.CloseAuction(ctx, &pb.CloseAuctionRequest{Auction: auctionID, Reason: reason})
+	case "cancel":
+		resp, err = c.CancelAuction(ctx, &pb.CancelAuctionRequest{Auction: auctionID, Reason: reason})
+	case "retract":
+		resp, err = c.RetractBid(ctx, &pb.RetractBidRequest{Auction: auctionID, Bid: bidID, Reason: reason})
+	default:
+		return fmt.Errorf("unknown admin command %q", cmd)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.Message)
+	return nil
+}
+
+// runBidder bids as bidder in auctionID on randomly chosen nodes, the
+// minimum the node accepts over the current highest bid, in rounds due
+// every interval, or every 1 to 5 seconds if interval is 0, until the
+// auction is over, ctx is done or clk reaches its end, which starts as end
+// and follows any soft-close extensions. In a sealed-bid auction it places
+// a single bid of a random amount above the minimum. In a Dutch auction it
+// accepts the price once it has dropped to a random share of the first
+// price it saw. If maxBid is positive, it instead gives the nodes a random
+// maximum of up to maxBid once, and they bid for it.
+func runBidder(ctx context.Context, clk clock.Clock, bidder, auctionID string, end time.Time, interval time.Duration, maxBid int32, nodes []string, dialOpts []grpc.DialOption, recorder *history.Recorder) {
+	logger := slog.Default().With("bidder", bidder, "auction", auctionID)
+	sealedBid := false
+	proxyBid := false
+	var valuation int32
+	// One connection to each node, made when first needed.
+	conns := map[string]*grpc.ClientConn{}
+	defer func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+	// Each round of a Result and maybe a Bid is one trace.
+	var span trace.Span
+	cancel := func() {}
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+		cancel()
+	}()
+	// due is when the round is meant to start. With an interval, rounds
+	// are due on a fixed schedule, whether or not the last one took longer
+	// than the interval: a late round starts at once, and the next is still
+	// due when it would have been, so that a slow node shows up as latency
+	// rather than as fewer calls.
+	due := clk.Now()
+	if interval > 0 {
+		// Start at a random point of the interval, so that the bidders do
+		// not all bid at once.
+		due = due.Add(time.Duration(rand.Int63n(int64(interval))))
+	}
+	for ; clk.Now().Before(end); due = due.Add(interval) {
+		if span != nil {
+			span.End()
+		}
+		cancel()
+
+		if interval == 0 {
+			due = clk.Now().Add(time.Duration(rand.Intn(5)+1) * time.Second)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-clk.After(due.Sub(clk.Now())):
+		}
+
+		// Randomly select a node
+		node := nodes[rand.Intn(len(nodes))]
+		conn := conns[node]
+		if conn == nil {
+			var err error
+			if conn, err = grpc.NewClient(node, dialOpts...); err != nil {
+				logger.Warn("failed to connect", "addr", node, "err", err)
+				continue
+			}
+			conns[node] = conn
+		}
+		c := pb.NewAuctionClient(conn)
+
+		ctx, cancelRound := context.WithTimeout(ctx, 5*time.Second)
+		cancel = cancelRound
+		ctx, span = otel.Tracer("MandatoryActivity5/Client").Start(ctx, "round", trace.WithAttributes(attribute.String("bidder", bidder), attribute.String("node", node)))
+		requestID := logging.NewRequestID()
+		ctx = logging.WithRequestID(ctx, requestID)
+		logger := logger.With("addr", node, "request_id", requestID)
+
+		// Get the current highest bid
+		// Its latency counts from when the round was due, including any
+		// time spent waiting for the rounds before it.
+		resultResp, err := recorder.Result(load.WithIntendedStart(ctx, due), c, bidder, &pb.ResultRequest{Auction: auctionID})
+		if err != nil {
+			logger.Warn("could not get result", "err", err)
+			continue
+		}
+		if resultResp.End != nil {
+			// A soft close may have moved the end.
+			end = resultResp.End.AsTime()
+		}
+
+		highestBid := resultResp.GetHighestbid()
+
+		// Check if the auction is over, or has not started yet
+		switch resultResp.GetState() {
+		case pb.AuctionState_CLOSED, pb.AuctionState_CANCELLED:
+			logger.Info("auction over", "result", highestBid)
+			return
+		case pb.AuctionState_NOT_STARTED:
+			continue
+		}
+
+		if resultResp.GetMode() == pb.AuctionMode_DUTCH {
+			price := resultResp.GetMinimumBid()
+			if valuation == 0 {
+				valuation = price * int32(50+rand.Intn(50)) / 100
+			}
+			if price > valuation {
+				continue
+			}
+			bidResp, err := recorder.Bid(ctx, c, bidder, &pb.BidRequest{Bidder: bidder, Amount: price, Auction: auctionID})
+			if err != nil {
+				logger.Warn("could not bid", "err", err)
+				continue
+			}
+			logger.Info("accepted the price", "price", price, "response", bidResp.Message)
+			continue
+		}
+
+		if mode := resultResp.GetMode(); mode == pb.AuctionMode_SEALED_FIRST_PRICE || mode == pb.AuctionMode_VICKREY {
+			if sealedBid {
+				continue
+			}
+			amount := resultResp.GetMinimumBid() + int32(rand.Intn(100))
+			bidResp, err := recorder.Bid(ctx, c, bidder, &pb.BidRequest{Bidder: bidder, Amount: amount, Auction: auctionID})
+			if err != nil {
+				logger.Warn("could not bid", "err", err)
+				continue
+			}
+			sealedBid = bidResp.Message == "success"
+			logger.Info("placed a sealed bid", "amount", amount, "response", bidResp.Message)
+			continue
+		}
+
+		if maxBid > 0 {
+			if proxyBid {
+				continue
+			}
+			amount := maxBid/2 + rand.Int31n(maxBid/2+1)
+			bidResp, err := recorder.Bid(ctx, c, bidder, &pb.BidRequest{Bidder: bidder, MaxAmount: amount, Auction: auctionID})
+			if err != nil {
+				logger.Warn("could not bid", "err", err)
+				continue
+			}
+			proxyBid = bidResp.Message == "success"
+			logger.Info("bidding automatically", "max_amount", amount, "response", bidResp.Message)
+			continue
+		}
+
+		// Place a new bid higher than the current highest bid
+		currentHighestBid, err := strconv.Atoi(highestBid)
+		if err != nil {
+			logger.Warn("could not convert highest bid to int", "err", err)
+			continue
+		}
+		newBidAmount := currentHighestBid + 1
+		if minimum := int(resultResp.GetMinimumBid()); minimum > newBidAmount {
+			newBidAmount = minimum
+		}
+		bidResp, err := recorder.Bid(ctx, c, bidder, &pb.BidRequest{Bidder: bidder, Amount: int32(newBidAmount), Auction: auctionID})
+		if err != nil {
+			logger.Warn("could not bid", "err", err)
+			continue
+		}
+		ts := bidResp.GetTimestamp()
+		logger.Info("bid", "amount", newBidAmount, "ts_wall", ts.GetWall(), "ts_logical", ts.GetLogical(), "response", bidResp.Message)
+	}
 }
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// Collector stands in for an OpenTelemetry collector: it receives spans
+// from the nodes and clients over OTLP and prints one line for each.
+// Spans of one trace share the trace ID, and the parent ID links every
+// span to the one that caused it.
+func main() {
+	addr := flag.String("addr", "localhost:4317", "address to receive OTLP traces on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	collectorpb.RegisterTraceServiceServer(grpcServer, &collector{})
+	log.Printf("collector listening at %v", lis.Addr())
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+type collector struct {
+	collectorpb.UnimplementedTraceServiceServer
+}
+
+func (c *collector) Export(ctx context.Context, req *collectorpb.ExportTraceServiceRequest) (*collectorpb.ExportTraceServiceResponse, error) {
+	for _, rs := range req.ResourceSpans {
+		service := "?"
+		for _, attr := range rs.GetResource().GetAttributes() {
+			if attr.Key == "service.name" {
+				service = attr.GetValue().GetStringValue()
+			}
+		}
+		for _, ss := range rs.ScopeSpans {
+			for _, span := range ss.Spans {
+				fmt.Println(format(service, span))
+			}
+		}
+	}
+	return &collectorpb.ExportTraceServiceResponse{}, nil
+}
+
+// format describes span, recorded by service, on one line.
+func format(service string, span *tracepb.Span) string {
+	parent := "-"
+	if len(span.ParentSpanId) > 0 {
+		parent = hex.EncodeToString(span.ParentSpanId)
+	}
+	var attrs []string
+	for _, attr := range span.Attributes {
+		attrs = append(attrs, fmt.Sprintf("%s=%s", attr.Key, value(attr.GetValue())))
+	}
+	start := time.Unix(0, int64(span.StartTimeUnixNano))
+	duration := time.Duration(span.EndTimeUnixNano - span.StartTimeUnixNano)
+	return fmt.Sprintf("%s trace %s span %s parent %s  %-8s %-40s %10s  %s",
+		start.Format("15:04:05.000000"), hex.EncodeToString(span.TraceId), hex.EncodeToString(span.SpanId), parent,
+		service, span.Name, duration, strings.Join(attrs, " "))
+}
+
+func value(v *commonpb.AnyValue) string {
+	switch v := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonpb.AnyValue_IntValue:
+		return fmt.Sprint(v.IntValue)
+	case *commonpb.AnyValue_BoolValue:
+		return fmt.Sprint(v.BoolValue)
+	case *commonpb.AnyValue_DoubleValue:
+		return fmt.Sprint(v.DoubleValue)
+	}
+	return "?"
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const usage = `Usage: %[1]s [flags]                          run the proxy
+       %[1]s [flags] latency <from> <to> <delay> [jitter]
+       %[1]s [flags] drop <from> <to>
+       %[1]s [flags] blackhole <from> <to> on|off
+       %[1]s [flags] partition <group> <group>...
+       %[1]s [flags] heal
+
+Endpoint 0 is the clients and 1 to n are the nodes. <from> and <to> are
+comma-separated endpoints, or * for all of them; a group is a
+comma-separated list of endpoints.
+`
+
+// Proxy sits between the nodes, and between the clients and the nodes, so
+// that faults can be injected into a cluster of real processes. Without a
+// command it runs the proxy; with one, it sends the command to a running
+// proxy's control service.
+func main() {
+	nodesFlag := flag.String("nodes", "localhost:50051,localhost:50052,localhost:50053", "comma-separated addresses of nodes 1 to n")
+	host := flag.String("host", "localhost", "host the proxy listens on")
+	base := flag.Int("base", 60000, "the link from endpoint i to node j listens on port base+10*i+j")
+	control := flag.String("control", "localhost:7000", "address of the control service")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage, os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	nodes := strings.Split(*nodesFlag, ",")
+	if len(nodes) > 9 {
+		log.Fatalf("the proxy supports at most 9 nodes, got %d", len(nodes))
+	}
+
+	if flag.NArg() == 0 {
+		serve(nodes, *host, *base, *control)
+		return
+	}
+
+	conn, err := grpc.NewClient(*control, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to proxy: %v", err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := command(ctx, pb.NewProxyClient(conn), flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(resp.Message)
+}
+
+func serve(nodes []string, host string, base int, control string) {
+	p := newProxy(nodes)
+	if err := p.listen(host, base); err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	// Print how to start the nodes and the client behind the proxy.
+	for id := 1; id <= len(nodes); id++ {
+		peers := make([]string, len(nodes))
+		for to := 1; to <= len(nodes); to++ {
+			peers[to-1] = fmt.Sprintf("%s:%d", host, listenPort(base, id, to))
+		}
+		peers[id-1] = nodes[id-1]
+		_, port, _ := net.SplitHostPort(nodes[id-1])
+		fmt.Printf("node %d:  go run . -id %d -peers %s %s\n", id, id, strings.Join(peers, ","), port)
+	}
+	clients := make([]string, len(nodes))
+	for to := 1; to <= len(nodes); to++ {
+		clients[to-1] = fmt.Sprintf("%s:%d", host, listenPort(base, 0, to))
+	}
+	fmt.Printf("client:  go run Client.go -nodes %s\n", strings.Join(clients, ","))
+
+	lis, err := net.Listen("tcp", control)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterProxyServer(grpcServer, p)
+	log.Printf("control service listening at %v", lis.Addr())
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// command sends the control command in args to the proxy.
+func command(ctx context.Context, c pb.ProxyClient, args []string) (*pb.ProxyResponse, error) {
+	name, args := args[0], args[1:]
+	switch {
+	case name == "latency" && (len(args) == 3 || len(args) == 4):
+		links, err := parseLinks(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		delay, err := time.ParseDuration(args[2])
+		if err != nil {
+			return nil, err
+		}
+		var jitter time.Duration
+		if len(args) == 4 {
+			if jitter, err = time.ParseDuration(args[3]); err != nil {
+				return nil, err
+			}
+		}
+		return c.SetLatency(ctx, &pb.LatencyRequest{Links: links, DelayMs: int32(delay.Milliseconds()), JitterMs: int32(jitter.Milliseconds())})
+	case name == "drop" && len(args) == 2:
+		links, err := parseLinks(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		return c.DropConnections(ctx, &pb.DropRequest{Links: links})
+	case name == "blackhole" && len(args) == 3 && (args[2] == "on" || args[2] == "off"):
+		links, err := parseLinks(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		return c.Blackhole(ctx, &pb.BlackholeRequest{Links: links, Enabled: args[2] == "on"})
+	case name == "partition" && len(args) >= 2:
+		req := &pb.PartitionRequest{}
+		for _, arg := range args {
+			endpoints, err := parseEndpoints(arg)
+			if err != nil {
+				return nil, err
+			}
+			req.Groups = append(req.Groups, &pb.PartitionGroup{Endpoints: endpoints})
+		}
+		return c.Partition(ctx, req)
+	case name == "heal" && len(args) == 0:
+		return c.Heal(ctx, &pb.HealRequest{})
+	}
+	flag.Usage()
+	os.Exit(2)
+	return nil, nil
+}
+
+func parseLinks(from, to string) (*pb.ProxyLinks, error) {
+	links := &pb.ProxyLinks{}
+	var err error
+	if from != "*" {
+		if links.From, err = parseEndpoints(from); err != nil {
+			return nil, err
+		}
+	}
+	if to != "*" {
+		if links.To, err = parseEndpoints(to); err != nil {
+			return nil, err
+		}
+	}
+	return links, nil
+}
+
+func parseEndpoints(s string) ([]int32, error) {
+	var endpoints []int32
+	for _, field := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q", field)
+		}
+		endpoints = append(endpoints, int32(id))
+	}
+	return endpoints, nil
+}
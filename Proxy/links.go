@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+)
+
+// link is the direction of traffic from one endpoint to another. Endpoint 0
+// is the clients and endpoints 1 to n are the nodes.
+type link struct {
+	from, to int
+}
+
+type linkFaults struct {
+	delay       time.Duration
+	jitter      time.Duration
+	blackhole   bool
+	partitioned bool
+}
+
+// proxy forwards TCP connections to the nodes, one listener per link, so
+// that it knows which endpoint every connection comes from and can apply
+// the faults of that link to it.
+type proxy struct {
+	pb.UnimplementedProxyServer
+
+	nodes []string
+
+	mu     sync.Mutex
+	faults map[link]linkFaults
+	conns  map[link]map[net.Conn]struct{}
+	rand   *rand.Rand
+}
+
+func newProxy(nodes []string) *proxy {
+	return &proxy{
+		nodes:  nodes,
+		faults: map[link]linkFaults{},
+		conns:  map[link]map[net.Conn]struct{}{},
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// listenPort returns the port traffic from one endpoint to another goes
+// through: base, then the source endpoint, then the destination node.
+func listenPort(base, from, to int) int {
+	return base + from*10 + to
+}
+
+// listen opens the listeners for every link into the nodes.
+func (p *proxy) listen(host string, base int) error {
+	for from := 0; from <= len(p.nodes); from++ {
+		for to := 1; to <= len(p.nodes); to++ {
+			if from == to {
+				continue
+			}
+			addr := fmt.Sprintf("%s:%d", host, listenPort(base, from, to))
+			lis, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+			log.Printf("link %d -> %d: %s -> %s", from, to, addr, p.nodes[to-1])
+			go p.accept(lis, link{from, to})
+		}
+	}
+	return nil
+}
+
+func (p *proxy) accept(lis net.Listener, l link) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			log.Printf("link %d -> %d: accept failed: %v", l.from, l.to, err)
+			return
+		}
+		go p.forward(conn, l)
+	}
+}
+
+// forward connects conn to the node at the end of l and copies traffic in
+// both directions until either side closes or the link's connections are
+// dropped.
+func (p *proxy) forward(conn net.Conn, l link) {
+	p.mu.Lock()
+	partitioned := p.faults[l].partitioned
+	p.mu.Unlock()
+	if partitioned {
+		conn.Close()
+		return
+	}
+
+	upstream, err := net.Dial("tcp", p.nodes[l.to-1])
+	if err != nil {
+		log.Printf("link %d -> %d: %v", l.from, l.to, err)
+		conn.Close()
+		return
+	}
+
+	p.track(l, conn, upstream)
+	defer p.untrack(l, conn, upstream)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		p.copy(upstream, conn, l)
+		done <- struct{}{}
+	}()
+	go func() {
+		// Responses travel back on the reverse link.
+		p.copy(conn, upstream, link{l.to, l.from})
+		done <- struct{}{}
+	}()
+	<-done
+	conn.Close()
+	upstream.Close()
+	<-done
+}
+
+// copy copies from src to dst, delaying or discarding every chunk as the
+// faults on l say at the time it is read.
+func (p *proxy) copy(dst io.Writer, src io.Reader, l link) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			p.mu.Lock()
+			f := p.faults[l]
+			delay := f.delay
+			if f.jitter > 0 {
+				delay += time.Duration(p.rand.Int63n(int64(2*f.jitter))) - f.jitter
+			}
+			p.mu.Unlock()
+
+			if f.blackhole {
+				continue
+			}
+			if delay > 0 {
+				time.Sleep(delay)
+			}
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (p *proxy) track(l link, conns ...net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns[l] == nil {
+		p.conns[l] = map[net.Conn]struct{}{}
+	}
+	for _, conn := range conns {
+		p.conns[l][conn] = struct{}{}
+	}
+}
+
+func (p *proxy) untrack(l link, conns ...net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, conn := range conns {
+		delete(p.conns[l], conn)
+	}
+}
+
+// drop closes every open connection on l. It must be called with p.mu held.
+func (p *proxy) drop(l link) int {
+	n := 0
+	for conn := range p.conns[l] {
+		conn.Close()
+		n++
+	}
+	// Both ends of a connection are tracked.
+	return n / 2
+}
+
+// selected returns the links chosen by sel, where an empty list of
+// endpoints means all of them.
+func (p *proxy) selected(sel *pb.ProxyLinks) []link {
+	all := func(ids []int32, from int) []int {
+		if len(ids) > 0 {
+			out := make([]int, len(ids))
+			for i, id := range ids {
+				out[i] = int(id)
+			}
+			return out
+		}
+		var out []int
+		for id := from; id <= len(p.nodes); id++ {
+			out = append(out, id)
+		}
+		return out
+	}
+
+	var links []link
+	for _, from := range all(sel.GetFrom(), 0) {
+		for _, to := range all(sel.GetTo(), 1) {
+			if from != to {
+				links = append(links, link{from, to})
+			}
+		}
+	}
+	return links
+}
+
+func (p *proxy) update(links []link, change func(*linkFaults)) {
+	for _, l := range links {
+		f := p.faults[l]
+		change(&f)
+		p.faults[l] = f
+	}
+}
+
+// SetLatency delays every chunk of data sent on the selected links by the
+// delay, plus or minus up to the jitter.
+func (p *proxy) SetLatency(ctx context.Context, req *pb.LatencyRequest) (*pb.ProxyResponse, error) {
+	links := p.selected(req.Links)
+	delay := time.Duration(req.DelayMs) * time.Millisecond
+	jitter := time.Duration(req.JitterMs) * time.Millisecond
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(links, func(f *linkFaults) { f.delay, f.jitter = delay, jitter })
+	return p.respond("latency %s ± %s on %d links", delay, jitter, len(links))
+}
+
+// DropConnections closes the open connections on the selected links. New
+// connections are accepted as usual.
+func (p *proxy) DropConnections(ctx context.Context, req *pb.DropRequest) (*pb.ProxyResponse, error) {
+	links := p.selected(req.Links)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := 0
+	for _, l := range links {
+		n += p.drop(l)
+	}
+	return p.respond("dropped %d connections on %d links", n, len(links))
+}
+
+// Blackhole silently discards all data sent on the selected links, while
+// keeping their connections open.
+func (p *proxy) Blackhole(ctx context.Context, req *pb.BlackholeRequest) (*pb.ProxyResponse, error) {
+	links := p.selected(req.Links)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(links, func(f *linkFaults) { f.blackhole = req.Enabled })
+	return p.respond("blackhole %t on %d links", req.Enabled, len(links))
+}
+
+// Partition cuts every link between endpoints in different groups, in both
+// directions: open connections are closed and new ones are refused.
+// Endpoints not named in any group are unaffected.
+func (p *proxy) Partition(ctx context.Context, req *pb.PartitionRequest) (*pb.ProxyResponse, error) {
+	var links []link
+	for i, a := range req.Groups {
+		for _, b := range req.Groups[i+1:] {
+			for _, from := range a.Endpoints {
+				for _, to := range b.Endpoints {
+					links = append(links, link{int(from), int(to)}, link{int(to), int(from)})
+				}
+			}
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(links, func(f *linkFaults) { f.partitioned = true })
+	for _, l := range links {
+		p.drop(l)
+	}
+	return p.respond("partitioned %d links", len(links))
+}
+
+// Heal removes every fault from every link.
+func (p *proxy) Heal(ctx context.Context, req *pb.HealRequest) (*pb.ProxyResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.faults = map[link]linkFaults{}
+	return p.respond("healed all links")
+}
+
+func (p *proxy) respond(format string, args ...any) (*pb.ProxyResponse, error) {
+	message := fmt.Sprintf(format, args...)
+	log.Print(message)
+	return &pb.ProxyResponse{Message: message}, nil
+}
@@ -2,59 +2,253 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"log/slog"
+	"maps"
+	"math/rand"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+	"MandatoryActivity5/logging"
+	"MandatoryActivity5/shiviz"
+	"MandatoryActivity5/tracing"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Node struct {
 	nodeID int
 	value  int
 	addr   string
-	active bool
+	active atomic.Bool
+	// left is set when the node announces that it is shutting down, and
+	// cleared once it is back up.
+	left   atomic.Bool
+	conn   *grpc.ClientConn
+	client pb.PeerClient
+	health healthpb.HealthClient
+	queue  chan *pb.ReplicateRequest
+	wake   chan struct{}
+	// flushes asks the worker to deliver everything queued so far, and
+	// closes the channel it is given once it has.
+	flushes chan chan struct{}
+	hints   *hintStore
+	// delivered marks how far this node's messages have been handed over
+	// to the node.
+	delivered *deliveryMark
+	hlc       *hlc
+	clock     clock.Clock
+	done      <-chan struct{}
+	// stopped is closed once the worker has stopped, if there is one.
+	stopped chan struct{}
+	log     *slog.Logger
+
+	// backoff and retryAt schedule the next attempt to hand off hints.
+	backoff time.Duration
+	retryAt <-chan time.Time
+}
+
+// appliedSeq is the last replication message applied from an origin node.
+type appliedSeq struct {
+	epoch int64
+	seq   int64
 }
 
 type AuctionServer struct {
 	pb.UnimplementedAuctionServer
-	nodes         []*Node
-	mu            sync.Mutex
-	highestBid    int32
-	highestBidder string
-	startTime     time.Time
+	nodeID   int
+	nodes    []*Node
+	mu       sync.Mutex
+	auctions map[string]*auction
+	hlc      *hlc
+	clock    clock.Clock
+	// epoch is the incarnation of this run of the node, and seq the number
+	// of the last message it replicated in it.
+	epoch   int64
+	seq     int64
+	applied map[int32]appliedSeq
+	done    chan struct{}
+	log     *slog.Logger
+	// adminToken is the token Admin calls must carry. If it is empty, the
+	// Admin service is disabled.
+	adminToken string
+	// clusterSecret is the secret the nodes send each other, which Peer
+	// calls must carry. If it is empty, the Peer service is disabled.
+	clusterSecret string
+	// subscribers receive the events of auctions as they happen.
+	subscribers map[*subscriber]bool
+	// health is the health service peers check this node with.
+	health *health.Server
+	// draining is set once the node is shutting down, and stops it from
+	// taking new work.
+	draining bool
+	// acceptors keeps the Paxos acceptor states of Dutch auctions on disk.
+	acceptors *acceptorLog
+	// state keeps the messages the node has applied on disk, and sent
+	// holds those of them this node replicated, to send again to peers
+	// that may have missed them.
+	state *stateLog
+	sent  []*pb.ReplicateRequest
+	// rand times the backoff of competing Paxos proposers.
+	rand *rand.Rand
 }
 
-func NewAuctionServer() *AuctionServer {
-	server := &AuctionServer{
-		nodes:     []*Node{},
-		startTime: time.Now(),
-	}
+func NewAuctionServer(nodeID int, clk clock.Clock) *AuctionServer {
+	server := newAuctionServer(nodeID, clk)
 	go server.healthCheck()
 	return server
 }
 
+// newAuctionServer returns a server without starting its health check, for
+// callers that drive it themselves.
+func newAuctionServer(nodeID int, clk clock.Clock) *AuctionServer {
+	now := clk.Now()
+	s := &AuctionServer{
+		nodeID:   nodeID,
+		nodes:    []*Node{},
+		auctions: map[string]*auction{},
+		hlc:      newHLC(clk),
+		clock:    clk,
+		applied:  map[int32]appliedSeq{},
+		done:     make(chan struct{}),
+		log:      slog.Default().With("node", nodeID),
+		health:   health.NewServer(),
+		rand:     rand.New(rand.NewSource(int64(nodeID))),
+	}
+	// The default auction is not replicated: every node opens its own copy
+	// for 100 seconds from when it starts.
+	s.auction(defaultAuction).create(terms{
+		start: timestamp{wall: now.UnixNano()},
+		end:   timestamp{wall: now.Add(100 * time.Second).UnixNano()},
+	}, timestamp{}, nodeID)
+	return s
+}
+
+// addPeer connects to another node of the cluster and starts replicating
+// to it. Hints for the node are kept in hintPath, and how far this node's
+// messages have been handed over to it in markPath. It must follow
+// openState, so that the messages the node missed are sent again.
+func (s *AuctionServer) addPeer(nodeID int, addr, hintPath, markPath string, opts ...grpc.DialOption) error {
+	node := s.newNode(nodeID, addr)
+	if err := node.open(hintPath, markPath); err != nil {
+		return err
+	}
+	node.resend(s.sent)
+	opts = append(opts, grpc.WithPerRPCCredentials(clusterCredentials(s.clusterSecret)))
+	if err := node.connect(opts...); err != nil {
+		node.close()
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes = append(s.nodes, node)
+	return nil
+}
+
+func (s *AuctionServer) newNode(nodeID int, addr string) *Node {
+	node := &Node{nodeID: nodeID, addr: addr, hlc: s.hlc, clock: s.clock, done: s.done, log: s.log.With("peer", nodeID)}
+	node.active.Store(true)
+	return node
+}
+
+// logger returns the server's logger, with the request ID of ctx.
+func (s *AuctionServer) logger(ctx context.Context) *slog.Logger {
+	return logging.Logger(ctx, s.log)
+}
+
+// register adds the Auction and Admin services and a health service, which
+// peers use to check whether this node is up, to grpcServer.
+func (s *AuctionServer) register(grpcServer *grpc.Server) {
+	pb.RegisterAuctionServer(grpcServer, s)
+	pb.RegisterPeerServer(grpcServer, &peerServer{AuctionServer: s})
+	pb.RegisterAdminServer(grpcServer, &adminServer{AuctionServer: s})
+	healthpb.RegisterHealthServer(grpcServer, s.health)
+}
+
+// Stop ends the server's background work and closes its peer connections.
+// It returns once the replication workers have stopped. Whatever they had
+// not handed over is sent again from the state log by the next run.
+func (s *AuctionServer) Stop() {
+	s.mu.Lock()
+	close(s.done)
+	nodes := s.nodes
+	for _, node := range nodes {
+		if node.conn != nil {
+			node.conn.Close()
+		}
+	}
+	s.acceptors.close()
+	s.state.close()
+	s.mu.Unlock()
+
+	for _, node := range nodes {
+		switch {
+		case node.stopped != nil:
+			<-node.stopped
+		case node.hints != nil:
+			// Nobody runs the node's worker, which would close its files.
+			node.close()
+		}
+	}
+}
+
 func (s *AuctionServer) healthCheck() {
 	for {
-		time.Sleep(10 * time.Second)
-		s.mu.Lock()
-		for _, node := range s.nodes {
-			conn, err := grpc.Dial(node.addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(2*time.Second))
-			if err != nil {
-				node.active = false
-				log.Printf("Node %d is down", node.nodeID)
-			} else {
-				node.active = true
-				conn.Close()
-				log.Printf("Node %d is active", node.nodeID)
+		select {
+		case <-s.done:
+			return
+		case <-s.clock.After(10 * time.Second):
+		}
+		s.checkPeers()
+	}
+}
+
+// checkPeers pings every peer, marking it active or down, and asks for
+// hints to be handed off to peers that are up.
+func (s *AuctionServer) checkPeers() {
+	s.mu.Lock()
+	nodes := s.nodes
+	s.mu.Unlock()
+
+	for _, node := range nodes {
+		active := node.ping(2 * time.Second)
+		wasActive := node.active.Swap(active)
+		if active {
+			peerUp.WithLabelValues(node.name()).Set(1)
+		} else {
+			peerUp.WithLabelValues(node.name()).Set(0)
+		}
+		switch {
+		case active:
+			node.left.Store(false)
+			node.log.Debug("peer is active", "queued", len(node.queue), "hints", node.hints.len())
+			if !wasActive || node.hints.len() > 0 {
+				node.retry()
 			}
+		case node.left.Load():
+			node.log.Debug("peer has left")
+		default:
+			node.log.Warn("peer is down")
 		}
-		s.mu.Unlock()
 	}
 }
 
@@ -62,40 +256,292 @@ func (s *AuctionServer) Bid(ctx context.Context, req *pb.BidRequest) (*pb.BidRes
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if req.Amount <= s.highestBid {
-		log.Printf("Bid from %s with amount %d failed", req.Bidder, req.Amount)
-		return &pb.BidResponse{Message: "fail"}, nil
+	if err := s.available(); err != nil {
+		return nil, err
 	}
+	ts := s.hlc.tick()
+	a, err := s.lookup(req.Auction)
+	if err != nil {
+		return nil, err
+	}
+	switch a.state(ts) {
+	case pb.AuctionState_NOT_STARTED:
+		return s.reject(ctx, a, req, ts, "auction has not started"), nil
+	case pb.AuctionState_CLOSED:
+		return s.reject(ctx, a, req, ts, "auction is over"), nil
+	case pb.AuctionState_CANCELLED:
+		return s.reject(ctx, a, req, ts, "auction was cancelled"), nil
+	}
+	if a.mode == pb.AuctionMode_DUTCH {
+		return s.acceptDutch(ctx, a, req, ts), nil
+	}
+	if req.MaxAmount != 0 {
+		return s.bidUpTo(ctx, a, req, ts)
+	}
+	// Only the node that accepts a bid checks it against the minimum.
+	// Replicas keep whichever of two concurrent bids is higher, even if it
+	// is less than an increment above the other. Above the minimum, an
+	// open bid always beats the highest bid here.
+	if minimum := a.minimum(); req.Amount < minimum {
+		return s.reject(ctx, a, req, ts, fmt.Sprintf("minimum is %d", minimum)), nil
+	}
+
+	s.applyBid(ctx, a, bid{bidder: req.Bidder, amount: req.Amount, timestamp: ts, nodeID: s.nodeID})
+	countBid(a, "accepted")
 
-	s.highestBid = req.Amount
-	s.highestBidder = req.Bidder
-	log.Printf("Bid from %s with amount %d succeeded", req.Bidder, req.Amount)
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op: &pb.ReplicateRequest_Bid{Bid: &pb.BidRequest{
+			Bidder:    req.Bidder,
+			Amount:    req.Amount,
+			Timestamp: ts.proto(),
+			Auction:   req.Auction,
+		}},
+	})
+	return &pb.BidResponse{Message: "success", Timestamp: ts.proto()}, nil
+}
 
-	// Replicate bid to other nodes
+// replicate stores msg and sends it to other nodes, including those that
+// are down, whose copies are kept as hints. It is called with s.mu held, so
+// every peer's queue is in the order operations were applied here. msg
+// carries the trace of ctx to the other nodes.
+func (s *AuctionServer) replicate(ctx context.Context, msg *pb.ReplicateRequest) {
+	s.seq++
+	msg.Origin, msg.Epoch, msg.Seq = int32(s.nodeID), s.epoch, s.seq
+	msg.TraceContext = tracing.Inject(ctx)
+	msg.RequestId = logging.RequestID(ctx)
+	if err := s.state.save(msg); err != nil {
+		s.logger(ctx).Error("failed to store the operation, it is lost if the node restarts", "seq", msg.Seq, "err", err)
+	}
 	for _, node := range s.nodes {
-		if node.active {
-			go s.replicateBid(node, req)
-		}
+		node.enqueue(msg)
+	}
+}
+
+// applyBid records b, which some node has accepted, in a and makes it the
+// highest bid if it beats the current one.
+// s.mu must be held.
+func (s *AuctionServer) applyBid(ctx context.Context, a *auction, b bid) bool {
+	if a.sold() {
+		// Only the decided acceptance is replicated for a Dutch auction,
+		// but more than one node may replicate it.
+		return false
+	}
+	end := a.closes()
+	a.record(record{bid: b})
+	s.publishRecord(a, record{bid: b})
+	log := s.logger(ctx).With("auction", a.id, "bidder", b.bidder, "amount", b.amount, "bid_ts", b.timestamp, "origin", b.nodeID)
+	a.logExtension(log, end)
+	if !a.counts(record{bid: b}) {
+		log.Info("bid recorded, retracted or after the auction was closed")
+		return false
+	}
+	a.bids = append(a.bids, b)
+	if !b.beats(a.highest) {
+		log.Info("bid applied, not highest")
+		return false
 	}
 
-	return &pb.BidResponse{Message: "success"}, nil
+	a.highest = b
+	observeHighest(a)
+	log.Info("bid applied, highest")
+	return true
 }
 
-func (s *AuctionServer) replicateBid(node *Node, req *pb.BidRequest) {
-	conn, err := grpc.Dial(node.addr, grpc.WithInsecure(), grpc.WithBlock())
-	if err != nil {
-		log.Printf("Failed to connect to node %d: %v", node.nodeID, err)
+// bidUpTo records the maximum bid of req's bidder in the English auction
+// a, and replicates it, so every node bids for them automatically.
+// s.mu must be held.
+func (s *AuctionServer) bidUpTo(ctx context.Context, a *auction, req *pb.BidRequest, ts timestamp) (*pb.BidResponse, error) {
+	if a.mode != pb.AuctionMode_ENGLISH {
+		return nil, status.Error(codes.InvalidArgument, "automatic bidding is only supported in English auctions")
+	}
+	leading := a.leading()
+	switch own, ok := a.proxies[req.Bidder]; {
+	case ok && req.MaxAmount <= own.amount:
+		return s.reject(ctx, a, req, ts, fmt.Sprintf("already bidding up to %d", own.amount)), nil
+	case leading.bidder != req.Bidder && req.MaxAmount < a.minimum():
+		return s.reject(ctx, a, req, ts, fmt.Sprintf("minimum is %d", a.minimum())), nil
+	}
+
+	s.applyMax(ctx, a, bid{bidder: req.Bidder, amount: req.MaxAmount, timestamp: ts, nodeID: s.nodeID})
+	countBid(a, "accepted")
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op: &pb.ReplicateRequest_Bid{Bid: &pb.BidRequest{
+			Bidder:    req.Bidder,
+			MaxAmount: req.MaxAmount,
+			Timestamp: ts.proto(),
+			Auction:   req.Auction,
+		}},
+	})
+	return &pb.BidResponse{Message: "success", Timestamp: ts.proto()}, nil
+}
+
+// applyMax records the maximum bid m, with the maximum as its amount, in a,
+// unless the bidder already has a higher one.
+// s.mu must be held.
+func (s *AuctionServer) applyMax(ctx context.Context, a *auction, m bid) {
+	if a.proxies == nil {
+		a.proxies = map[string]bid{}
+	}
+	end := a.closes()
+	a.record(record{bid: m, automatic: true})
+	s.publishRecord(a, record{bid: m, automatic: true})
+	log := s.logger(ctx).With("auction", a.id, "bidder", m.bidder, "max_amount", m.amount, "bid_ts", m.timestamp, "origin", m.nodeID)
+	a.logExtension(log, end)
+	if !a.counts(record{bid: m, automatic: true}) {
+		log.Info("maximum bid recorded, retracted or after the auction was closed")
 		return
 	}
-	defer conn.Close()
+	if own, ok := a.proxies[m.bidder]; ok && !m.beats(own) {
+		return
+	}
+	a.proxies[m.bidder] = m
+	observeHighest(a)
+	leading := a.leading()
+	log.Info("maximum bid recorded", "leader", leading.bidder, "leading_amount", leading.amount)
+}
 
-	client := pb.NewAuctionClient(conn)
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+// lookup returns the auction with the given ID, or a NotFound error if it
+// has not been created, as far as this node knows.
+// s.mu must be held.
+func (s *AuctionServer) lookup(id string) (*auction, error) {
+	a := s.auctions[id]
+	if a == nil || !a.created {
+		return nil, status.Errorf(codes.NotFound, "auction %q does not exist", id)
+	}
+	return a, nil
+}
+
+// auction returns the auction with the given ID, adding it if this node
+// has not heard of it yet.
+// s.mu must be held.
+func (s *AuctionServer) auction(id string) *auction {
+	a := s.auctions[id]
+	if a == nil {
+		a = &auction{id: id}
+		s.auctions[id] = a
+	}
+	return a
+}
+
+// CreateAuction schedules a new auction and replicates it. The start and
+// end times are resolved here, so that every node opens and closes the
+// auction at the same point on the hybrid logical clock.
+func (s *AuctionServer) CreateAuction(ctx context.Context, req *pb.CreateAuctionRequest) (*pb.CreateAuctionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	_, err = client.Bid(ctx, req)
+	if err := s.available(); err != nil {
+		return nil, err
+	}
+	ts := s.hlc.tick()
+	switch req.Auction {
+	case defaultAuction:
+		return nil, status.Error(codes.InvalidArgument, "auction ID is required")
+	case defaultAuctionName:
+		return nil, status.Errorf(codes.InvalidArgument, "auction ID %q is the name of the default auction", req.Auction)
+	}
+	if a := s.auctions[req.Auction]; a != nil && a.created {
+		return nil, status.Errorf(codes.AlreadyExists, "auction %q already exists", req.Auction)
+	}
+
+	t, err := termsFromProto(req, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	a := s.auction(req.Auction)
+	a.create(t, ts, s.nodeID)
+	s.logger(ctx).Info("auction created", "auction", a.id, "ts", ts, "start", t.start, "end", t.end, "mode", t.mode.String())
+
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op:        &pb.ReplicateRequest_Create{Create: t.proto(req.Auction, ts)},
+	})
+	return &pb.CreateAuctionResponse{Message: "success", Timestamp: ts.proto()}, nil
+}
+
+// Replicate stores and applies a message sent by another node. Messages
+// from the same origin arrive in order, but hints may be delivered more
+// than once, so anything at or below the last applied sequence number is
+// ignored.
+func (s *peerServer) Replicate(ctx context.Context, req *pb.ReplicateRequest) (*pb.ReplicateResponse, error) {
+	if err := s.authenticatePeer(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.isPeer(req.Origin) {
+		return nil, status.Errorf(codes.PermissionDenied, "node %d is not a peer of this node", req.Origin)
+	}
+	ts, err := s.hlc.update(fromProto(req.Timestamp))
 	if err != nil {
-		log.Printf("Failed to replicate bid to node %d: %v", node.nodeID, err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	last := s.applied[req.Origin]
+	if req.Epoch < last.epoch || (req.Epoch == last.epoch && req.Seq <= last.seq) {
+		return &pb.ReplicateResponse{Timestamp: ts.proto()}, nil
+	}
+	if err := s.state.save(req); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store the message: %v", err)
+	}
+	s.applied[req.Origin] = appliedSeq{epoch: req.Epoch, seq: req.Seq}
+	s.apply(ctx, req, ts)
+	return &pb.ReplicateResponse{Timestamp: ts.proto()}, nil
+}
+
+// apply applies the operation of msg, which its origin applied first, at
+// ts on this node's clock.
+// s.mu must be held.
+func (s *AuctionServer) apply(ctx context.Context, req *pb.ReplicateRequest, ts timestamp) {
+	switch op := req.Op.(type) {
+	case *pb.ReplicateRequest_Bid:
+		if op.Bid.MaxAmount != 0 {
+			s.applyMax(ctx, s.auction(op.Bid.Auction), bid{
+				bidder:    op.Bid.Bidder,
+				amount:    op.Bid.MaxAmount,
+				timestamp: fromProto(op.Bid.Timestamp),
+				nodeID:    int(req.Origin),
+			})
+			break
+		}
+		s.applyBid(ctx, s.auction(op.Bid.Auction), bid{
+			bidder:    op.Bid.Bidder,
+			amount:    op.Bid.Amount,
+			timestamp: fromProto(op.Bid.Timestamp),
+			nodeID:    int(req.Origin),
+		})
+	case *pb.ReplicateRequest_Rejection:
+		bid := op.Rejection.Bid
+		a, r := s.auction(bid.Auction), rejection(bid, fromProto(bid.Timestamp), int(req.Origin), op.Rejection.Reason)
+		a.record(r)
+		s.publishRecord(a, r)
+	case *pb.ReplicateRequest_Close:
+		s.applyClose(ctx, s.auction(op.Close.Auction), fromProto(op.Close.Timestamp), op.Close.Reason)
+	case *pb.ReplicateRequest_Cancel:
+		s.applyCancel(ctx, s.auction(op.Cancel.Auction), op.Cancel.Reason)
+	case *pb.ReplicateRequest_Retract:
+		p, err := parsePosition(op.Retract.Bid)
+		if err != nil {
+			s.logger(ctx).Warn("ignoring retraction", "auction", op.Retract.Auction, "bid", op.Retract.Bid, "origin", req.Origin, "err", err)
+			break
+		}
+		s.applyRetract(ctx, s.auction(op.Retract.Auction), p, op.Retract.Reason)
+	case *pb.ReplicateRequest_Create:
+		t, err := termsFromProto(op.Create, ts)
+		if err != nil {
+			// The origin checked the terms, so this only happens if it runs
+			// a different version with other rules.
+			s.logger(ctx).Warn("ignoring auction", "auction", op.Create.Auction, "origin", req.Origin, "err", err)
+			break
+		}
+		a := s.auction(op.Create.Auction)
+		if a.create(t, fromProto(op.Create.Timestamp), int(req.Origin)) {
+			s.logger(ctx).Info("auction created", "auction", a.id, "origin", req.Origin, "start", t.start, "end", t.end, "mode", t.mode.String())
+		}
 	}
 }
 
@@ -103,45 +549,220 @@ func (s *AuctionServer) Result(ctx context.Context, req *pb.ResultRequest) (*pb.
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if time.Since(s.startTime) < 100*time.Second {
-		log.Printf("Current highest bid: %d by %s", s.highestBid, s.highestBidder)
-		return &pb.ResultResponse{Highestbid: fmt.Sprintf("%d", s.highestBid)}, nil
+	ts := s.hlc.tick()
+	a, err := s.lookup(req.Auction)
+	if err != nil {
+		return nil, err
+	}
+	resultsTotal.WithLabelValues(a.label()).Inc()
+	resp := &pb.ResultResponse{Timestamp: ts.proto(), State: a.state(ts), ReserveMet: a.reserveMet(), MinimumBid: a.minimum(), Mode: a.mode, End: timestamppb.New(a.closes().time())}
+	log := s.logger(ctx).With("auction", a.id, "ts", ts)
+	if resp.State == pb.AuctionState_CANCELLED {
+		log.Debug("result: auction cancelled")
+		resp.Highestbid = "Auction cancelled, no winner"
+		resp.ReserveMet = false
+		return resp, nil
+	}
+	if resp.State != pb.AuctionState_CLOSED && a.sealed() {
+		log.Debug("result: bids are sealed", "bids", len(a.bids))
+		resp.Highestbid = "Bids are sealed until the auction closes"
+		resp.ReserveMet = false
+		return resp, nil
+	}
+	if resp.State != pb.AuctionState_CLOSED && a.mode == pb.AuctionMode_DUTCH {
+		resp.MinimumBid = a.priceAt(ts)
+		log.Debug("result: current price", "price", resp.MinimumBid)
+		resp.Highestbid = "0"
+		return resp, nil
+	}
+	leading := a.leading()
+	if resp.State != pb.AuctionState_CLOSED {
+		log.Debug("result: current highest bid", "amount", leading.amount, "bidder", leading.bidder)
+		resp.Highestbid = fmt.Sprintf("%d", leading.amount)
+		return resp, nil
+	}
+	if !resp.ReserveMet {
+		log.Info("result: auction over, reserve not met", "amount", leading.amount, "reserve", a.reserve)
+		resp.Highestbid = fmt.Sprintf("Auction over. Reserve of %d not met, no winner", a.reserve)
+		return resp, nil
 	}
 
-	log.Printf("Auction over. Winner: %s with bid %d", s.highestBidder, s.highestBid)
-	return &pb.ResultResponse{Highestbid: fmt.Sprintf("Auction over. Winner: %s with bid %d", s.highestBidder, s.highestBid)}, nil
+	resp.Price = a.price()
+	log.Info("result: auction over", "winner", leading.bidder, "amount", leading.amount, "price", resp.Price)
+	resp.Highestbid = fmt.Sprintf("Auction over. Winner: %s with bid %d", leading.bidder, leading.amount)
+	if resp.Price != leading.amount {
+		resp.Highestbid += fmt.Sprintf(", pays %d", resp.Price)
+	}
+	return resp, nil
+}
+
+// ListAuctions lists every auction this node knows has been created.
+func (s *AuctionServer) ListAuctions(ctx context.Context, req *pb.ListAuctionsRequest) (*pb.ListAuctionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts := s.hlc.tick()
+	resp := &pb.ListAuctionsResponse{Timestamp: ts.proto()}
+	for _, id := range slices.Sorted(maps.Keys(s.auctions)) {
+		a := s.auctions[id]
+		if !a.created {
+			continue
+		}
+		resp.Auctions = append(resp.Auctions, &pb.AuctionSummary{
+			Auction: a.id,
+			Mode:    a.mode,
+			State:   a.state(ts),
+			Start:   timestamppb.New(a.start.time()),
+			End:     timestamppb.New(a.closes().time()),
+		})
+	}
+	return resp, nil
 }
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatalf("Usage: %s <port>", os.Args[0])
+	id := flag.Int("id", 0, "ID of this node, from 1 to the number of nodes; 0 to take it from the port, which must then be one of 50051 to 50053")
+	shivizPath := flag.String("shiviz", "", "also write a ShiViz vector clock log of every call to this file")
+	simulateFlag := flag.Bool("simulate", false, "run a deterministic simulation of the cluster instead of serving")
+	seed := flag.Int64("seed", 1, "seed of the first simulation")
+	runs := flag.Int("runs", 1, "number of simulations to run, with consecutive seeds")
+	peers := flag.String("peers", "", "comma-separated addresses to reach nodes 1 to 3 at, for example through the chaos proxy")
+	traceDest := flag.String("trace", "", "export traces to stdout, or to the OTLP collector at this address; empty for none")
+	logConfig := logging.Flags("", "file to write JSON logs to; empty for node<id>.log")
+	metricsAddr := flag.String("metrics", "", "address to serve Prometheus metrics on at /metrics; empty for the gRPC port plus 1000")
+	httpAddr := flag.String("http", "", "address to serve the HTTP/JSON gateway on; empty for none")
+	adminToken := flag.String("admin-token", "", "token Admin calls must carry; empty for $AUCTION_ADMIN_TOKEN, and if that is empty too the Admin service is disabled")
+	clusterSecret := flag.String("cluster-secret", "", "secret the nodes authenticate each other with; empty for $AUCTION_CLUSTER_SECRET, which must then be set")
+	maxOffset := flag.Duration("max-offset", defaultMaxOffset, "how far ahead of this node's clock another node's timestamps may be before they are refused")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 30*time.Second, "how long to wait on SIGINT or SIGTERM for replication to be flushed and calls to finish before stopping anyway")
+	flag.Parse()
+	if *simulateFlag {
+		os.Exit(runSimulations(*seed, *runs))
+	}
+	if flag.NArg() != 1 {
+		log.Fatalf("Usage: %s [-id n] [-shiviz file] [-peers addrs] [-metrics addr] [-http addr] [-trace dest] [-admin-token token] [-cluster-secret secret] <port>\n       %s -simulate [-seed n] [-runs n]", os.Args[0], os.Args[0])
 	}
-	port := os.Args[1]
+	port := flag.Arg(0)
 
-	// Set up logging to a file
-	logFile, err := os.OpenFile("log.txt", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
-	if err != nil {
-		log.Fatalf("failed to open log file: %v", err)
+	addrs := []string{"localhost:50051", "localhost:50052", "localhost:50053"}
+	nodeID := *id
+	if nodeID == 0 {
+		for i, addr := range addrs {
+			if addr == "localhost:"+port {
+				nodeID = i + 1
+			}
+		}
+		if nodeID == 0 {
+			log.Fatalf("port %s is not one of the default nodes; set the node's ID with -id", port)
+		}
 	}
+	if *peers != "" {
+		addrs = strings.Split(*peers, ",")
+	}
+	if nodeID < 1 || nodeID > len(addrs) {
+		log.Fatalf("invalid node ID %d: there are %d nodes", nodeID, len(addrs))
+	}
+
+	// Log to a file of this node's own. Lines logged through the log
+	// package, like fatal errors, go there too.
+	if logConfig.Path == "" {
+		logConfig.Path = fmt.Sprintf("node%d.log", nodeID)
+	}
+	logger, logFile := logConfig.Open()
 	defer logFile.Close()
-	log.SetOutput(logFile)
+	slog.SetDefault(logger)
 
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	server := NewAuctionServer()
-	server.nodes = []*Node{
-		{nodeID: 1, addr: "localhost:50051", active: true},
-		{nodeID: 2, addr: "localhost:50052", active: true},
-		{nodeID: 3, addr: "localhost:50053", active: true},
+	if *traceDest != "" {
+		stop, err := tracing.Start(context.Background(), fmt.Sprintf("node%d", nodeID), *traceDest)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer stop(context.Background())
+	}
+
+	serverOpts := []grpc.ServerOption{tracing.ServerOption(), grpc.ChainUnaryInterceptor(logging.UnaryServerInterceptor())}
+	dialOpts := []grpc.DialOption{tracing.DialOption(), grpc.WithChainUnaryInterceptor(logging.UnaryClientInterceptor())}
+	if *shivizPath != "" {
+		shivizLog, err := shiviz.Create(*shivizPath)
+		if err != nil {
+			log.Fatalf("failed to open ShiViz log: %v", err)
+		}
+		defer shivizLog.Close()
+		process := shivizLog.Process(fmt.Sprintf("node%d", nodeID))
+		serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(process.UnaryServerInterceptor()))
+		dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(process.UnaryClientInterceptor()))
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	server := NewAuctionServer(nodeID, clock.Real)
+	if server.epoch, err = nextIncarnation(fmt.Sprintf("incarnation-%d", nodeID)); err != nil {
+		log.Fatalf("failed to start a new incarnation: %v", err)
+	}
+	if err := server.openAcceptors(fmt.Sprintf("paxos-%d.log", nodeID)); err != nil {
+		log.Fatalf("failed to open the Paxos log: %v", err)
+	}
+	if err := server.openState(fmt.Sprintf("state-%d.log", nodeID)); err != nil {
+		log.Fatalf("failed to restore the state: %v", err)
+	}
+	server.adminToken = *adminToken
+	server.hlc.maxOffset = *maxOffset
+	server.clusterSecret = *clusterSecret
+	if server.clusterSecret == "" {
+		server.clusterSecret = os.Getenv("AUCTION_CLUSTER_SECRET")
+	}
+	if server.clusterSecret == "" {
+		log.Fatal("a cluster secret is required: set -cluster-secret or $AUCTION_CLUSTER_SECRET")
+	}
+	if server.adminToken == "" {
+		server.adminToken = os.Getenv("AUCTION_ADMIN_TOKEN")
+	}
+	for i, addr := range addrs {
+		if i+1 == nodeID {
+			continue
+		}
+		if err := server.addPeer(i+1, addr, fmt.Sprintf("hints-%d-%d.log", nodeID, i+1), fmt.Sprintf("delivered-%d-%d", nodeID, i+1), dialOpts...); err != nil {
+			log.Fatalf("failed to connect to node %d: %v", i+1, err)
+		}
+	}
+	server.register(grpcServer)
+
+	if *metricsAddr == "" {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			log.Fatalf("invalid port %q", port)
+		}
+		*metricsAddr = fmt.Sprintf(":%d", p+1000)
+	}
+	httpServers := []*http.Server{serveMetrics(*metricsAddr, server.log)}
+	if *httpAddr != "" {
+		httpServers = append(httpServers, serveGateway(*httpAddr, server))
 	}
-	pb.RegisterAuctionServer(grpcServer, server)
 
-	log.Printf("server listening at %v", lis.Addr())
-	if err := grpcServer.Serve(lis); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	served := make(chan error, 1)
+	go func() {
+		served <- grpcServer.Serve(lis)
+	}()
+	server.log.Info("server listening", "addr", lis.Addr().String())
+	select {
+	case err := <-served:
 		log.Fatalf("failed to serve: %v", err)
+	case <-ctx.Done():
+	}
+	// A second signal kills the node at once.
+	stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	server.Shutdown(ctx, grpcServer)
+	server.Stop()
+	for _, srv := range httpServers {
+		srv.Shutdown(ctx)
 	}
+	server.log.Info("server stopped")
 }
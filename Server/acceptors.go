@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"sync"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// acceptorLog keeps the Paxos acceptor state of every Dutch auction in an
+// append-only file. A promise or acceptance must survive a crash of the
+// node that made it, or the node could break it once it is back, and two
+// acceptances could win. A nil *acceptorLog keeps nothing.
+type acceptorLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// openAcceptorLog loads the acceptor states left in path by a previous run,
+// the last one written for each auction, and opens the file for appending.
+func openAcceptorLog(path string) (*acceptorLog, map[string]acceptor, error) {
+	states := map[string]acceptor{}
+	f, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+	if err == nil {
+		r := bufio.NewReader(f)
+		for {
+			state := &pb.AcceptorState{}
+			// Stop at EOF or at a torn write left by a crash. The state
+			// being written then had not been acted on yet.
+			if err := protodelim.UnmarshalFrom(r, state); err != nil {
+				break
+			}
+			states[state.Auction] = acceptor{
+				promised:       ballotFromProto(state.Promised),
+				acceptedBallot: ballotFromProto(state.AcceptedBallot),
+				accepted:       acceptanceFromProto(state.Accepted),
+			}
+		}
+		f.Close()
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &acceptorLog{file: file}, states, nil
+}
+
+// save stores the acceptor state p of the auction id durably.
+func (l *acceptorLog) save(id string, p acceptor) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state := &pb.AcceptorState{Auction: id, Promised: p.promised.proto(), AcceptedBallot: p.acceptedBallot.proto()}
+	if p.accepted != nil {
+		state.Accepted = p.accepted.proto()
+	}
+	if _, err := protodelim.MarshalTo(l.file, state); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+func (l *acceptorLog) close() error {
+	if l == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+// openAcceptors restores the acceptor states a previous run of the node
+// left in path, and keeps the new ones there.
+func (s *AuctionServer) openAcceptors(path string) error {
+	l, states, err := openAcceptorLog(path)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.acceptors = l
+	for id, p := range states {
+		s.auction(id).paxos = p
+	}
+	return nil
+}
+
+// updateAcceptor applies change to a copy of the acceptor state of a and,
+// if change reports that it promised or accepted, saves the copy before it
+// takes effect. It reports whether it did. s.mu must be held.
+func (s *AuctionServer) updateAcceptor(a *auction, change func(*acceptor) bool) (bool, error) {
+	next := a.paxos
+	if !change(&next) {
+		return false, nil
+	}
+	if err := s.acceptors.save(a.id, next); err != nil {
+		return false, err
+	}
+	a.paxos = next
+	return true, nil
+}
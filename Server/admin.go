@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+	"strings"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminServer is the Admin service of a node. Like bids, the auctioneer's
+// decisions are applied where they are made and replicated to the other
+// nodes, ordered by their timestamps, so all nodes end up agreeing on them
+// whichever node each one reached.
+type adminServer struct {
+	pb.UnimplementedAdminServer
+	*AuctionServer
+}
+
+// authorize checks that ctx carries the admin token of the node. Without
+// one the Admin service is disabled.
+func (s *AuctionServer) authorize(ctx context.Context) error {
+	if s.adminToken == "" {
+		return status.Error(codes.PermissionDenied, "admin service is disabled on this node")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(v, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "a valid admin token is required")
+}
+
+// CloseAuction closes an auction now, before its end. Bids other nodes
+// accepted before now still count, and any from now on do not.
+func (s *adminServer) CloseAuction(ctx context.Context, req *pb.CloseAuctionRequest) (*pb.AdminResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.available(); err != nil {
+		return nil, err
+	}
+	ts := s.hlc.tick()
+	a, err := s.lookup(req.Auction)
+	if err != nil {
+		return nil, err
+	}
+	switch a.state(ts) {
+	case pb.AuctionState_CLOSED:
+		return nil, status.Error(codes.FailedPrecondition, "auction is already closed")
+	case pb.AuctionState_CANCELLED:
+		return nil, status.Error(codes.FailedPrecondition, "auction was cancelled")
+	}
+
+	s.applyClose(ctx, a, ts, req.Reason)
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op:        &pb.ReplicateRequest_Close{Close: &pb.CloseAuctionRequest{Auction: req.Auction, Timestamp: ts.proto(), Reason: req.Reason}},
+	})
+	return &pb.AdminResponse{Message: "success", Timestamp: ts.proto()}, nil
+}
+
+// CancelAuction cancels an auction, open or not, so that nobody wins it.
+func (s *adminServer) CancelAuction(ctx context.Context, req *pb.CancelAuctionRequest) (*pb.AdminResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.available(); err != nil {
+		return nil, err
+	}
+	ts := s.hlc.tick()
+	a, err := s.lookup(req.Auction)
+	if err != nil {
+		return nil, err
+	}
+	if a.cancelled {
+		return nil, status.Error(codes.FailedPrecondition, "auction was cancelled")
+	}
+
+	s.applyCancel(ctx, a, req.Reason)
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op:        &pb.ReplicateRequest_Cancel{Cancel: &pb.CancelAuctionRequest{Auction: req.Auction, Timestamp: ts.proto(), Reason: req.Reason}},
+	})
+	return &pb.AdminResponse{Message: "success", Timestamp: ts.proto()}, nil
+}
+
+// RetractBid retracts an accepted bid or maximum, and the leading bid is
+// worked out again from the rest of the history. Bids in a Dutch auction
+// cannot be retracted, since the acceptance Paxos decided stays decided.
+func (s *adminServer) RetractBid(ctx context.Context, req *pb.RetractBidRequest) (*pb.AdminResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.available(); err != nil {
+		return nil, err
+	}
+	ts := s.hlc.tick()
+	a, err := s.lookup(req.Auction)
+	if err != nil {
+		return nil, err
+	}
+	p, err := parsePosition(req.Bid)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bid ID %q", req.Bid)
+	}
+	switch {
+	case a.mode == pb.AuctionMode_DUTCH:
+		return nil, status.Error(codes.FailedPrecondition, "bids in a Dutch auction cannot be retracted")
+	case a.cancelled:
+		return nil, status.Error(codes.FailedPrecondition, "auction was cancelled")
+	}
+	i, found := slices.BinarySearchFunc(a.history, p, func(h record, p position) int {
+		return h.position().compare(p)
+	})
+	if !found || a.history[i].rejected {
+		return nil, status.Errorf(codes.NotFound, "no accepted bid %s", req.Bid)
+	}
+	if _, ok := a.retracted[p]; ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "bid %s is already retracted", req.Bid)
+	}
+
+	s.applyRetract(ctx, a, p, req.Reason)
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op:        &pb.ReplicateRequest_Retract{Retract: &pb.RetractBidRequest{Auction: req.Auction, Bid: req.Bid, Timestamp: ts.proto(), Reason: req.Reason}},
+	})
+	return &pb.AdminResponse{Message: "success", Timestamp: ts.proto()}, nil
+}
+
+// applyClose closes a early at ts, unless it was already closed earlier.
+// s.mu must be held.
+func (s *AuctionServer) applyClose(ctx context.Context, a *auction, ts timestamp, reason string) {
+	if a.closedEarly && !ts.before(a.closedAt) {
+		return
+	}
+	a.closedEarly, a.closedAt = true, ts
+	a.rebuild()
+	observeHighest(a)
+	s.logger(ctx).Info("auction closed early", "auction", a.id, "at", ts, "reason", reason)
+	s.publish(a, "close", &pb.CloseAuctionRequest{Auction: a.id, Timestamp: ts.proto(), Reason: reason})
+}
+
+// applyCancel cancels a.
+// s.mu must be held.
+func (s *AuctionServer) applyCancel(ctx context.Context, a *auction, reason string) {
+	if a.cancelled {
+		return
+	}
+	a.cancelled = true
+	s.logger(ctx).Info("auction cancelled", "auction", a.id, "reason", reason)
+	s.publish(a, "cancel", &pb.CancelAuctionRequest{Auction: a.id, Reason: reason})
+}
+
+// applyRetract retracts the bid at p in a. The bid may not have reached
+// this node yet, in which case it is ignored once it does.
+// s.mu must be held.
+func (s *AuctionServer) applyRetract(ctx context.Context, a *auction, p position, reason string) {
+	if _, ok := a.retracted[p]; ok {
+		return
+	}
+	if a.retracted == nil {
+		a.retracted = map[position]string{}
+	}
+	a.retracted[p] = reason
+	a.rebuild()
+	observeHighest(a)
+	leading := a.leading()
+	s.logger(ctx).Info("bid retracted", "auction", a.id, "bid", p.String(), "reason", reason, "leader", leading.bidder, "leading_amount", leading.amount)
+	s.publish(a, "retract", &pb.RetractBidRequest{Auction: a.id, Bid: p.String(), Reason: reason})
+}
+
+// counts reports whether the record r counts towards the result of the
+// auction: whether it was accepted, and neither retracted nor made after
+// the auction was closed early.
+func (a *auction) counts(r record) bool {
+	if r.rejected {
+		return false
+	}
+	if _, ok := a.retracted[r.position()]; ok {
+		return false
+	}
+	return !a.closedEarly || r.timestamp.before(a.closedAt)
+}
+
+// outcome returns how the record r stands, and why, for ListBids.
+func (a *auction) outcome(r record) (pb.BidOutcome, string) {
+	if r.rejected {
+		return pb.BidOutcome_REJECTED, r.reason
+	}
+	if reason, ok := a.retracted[r.position()]; ok {
+		return pb.BidOutcome_RETRACTED, reason
+	}
+	if !a.counts(r) {
+		return pb.BidOutcome_REJECTED, "auction was closed early"
+	}
+	return pb.BidOutcome_ACCEPTED, ""
+}
+
+// rebuild works out the bids, highest bid and maximums of a again from the
+// records in its history that count. They depend only on that set, so the
+// result is the same as if the records that do not count had never been
+// applied.
+func (a *auction) rebuild() {
+	a.bids, a.highest, a.proxies = nil, bid{}, nil
+	for _, r := range a.history {
+		if !a.counts(r) {
+			continue
+		}
+		if !r.automatic {
+			a.bids = append(a.bids, r.bid)
+			if r.beats(a.highest) {
+				a.highest = r.bid
+			}
+			continue
+		}
+		if a.proxies == nil {
+			a.proxies = map[string]bid{}
+		}
+		if own, ok := a.proxies[r.bidder]; !ok || r.beats(own) {
+			a.proxies[r.bidder] = r.bid
+		}
+	}
+}
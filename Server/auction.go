@@ -0,0 +1,325 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultAuction is the ID of the auction every node opens when it starts,
+// which requests without an auction ID refer to.
+const defaultAuction = ""
+
+// defaultAuctionName is what the default auction is called where an empty
+// ID cannot be used: in gateway paths and metric labels. No other auction
+// may have it as its ID.
+const defaultAuctionName = "default"
+
+// terms are the rules of an auction, fixed when it is created.
+type terms struct {
+	// start and end are when, on the hybrid logical clock, the auction opens
+	// and closes.
+	start, end timestamp
+	// opening is the lowest first bid and reserve the lowest winning bid.
+	opening, reserve int32
+	increments       []increment
+	mode             pb.AuctionMode
+	// decrement and interval are the price schedule of a Dutch auction.
+	decrement int32
+	interval  time.Duration
+	// window and extension are the soft close: a bid within window of the
+	// end extends it by extension.
+	window, extension time.Duration
+}
+
+// increment is the minimum raise over a highest bid of from or more.
+type increment struct {
+	from, step int32
+}
+
+// termsFromProto reads the terms of a CreateAuction request received at
+// now, checking that they make sense.
+func termsFromProto(req *pb.CreateAuctionRequest, now timestamp) (terms, error) {
+	t := terms{
+		start:     timestamp{wall: now.wall},
+		opening:   req.Opening,
+		reserve:   req.Reserve,
+		mode:      req.Mode,
+		decrement: req.Decrement,
+		interval:  req.DecrementInterval.AsDuration(),
+		window:    req.SoftCloseWindow.AsDuration(),
+		extension: req.SoftCloseExtension.AsDuration(),
+	}
+	if req.Start != nil {
+		t.start = timestamp{wall: req.Start.AsTime().UnixNano()}
+	}
+	switch close := req.Close.(type) {
+	case *pb.CreateAuctionRequest_End:
+		t.end = timestamp{wall: close.End.AsTime().UnixNano()}
+	case *pb.CreateAuctionRequest_Duration:
+		t.end = timestamp{wall: t.start.wall + close.Duration.AsDuration().Nanoseconds()}
+	default:
+		return terms{}, status.Error(codes.InvalidArgument, "end or duration is required")
+	}
+	if !t.start.before(t.end) {
+		return terms{}, status.Error(codes.InvalidArgument, "auction must end after it starts")
+	}
+	if _, ok := pb.AuctionMode_name[int32(t.mode)]; !ok {
+		return terms{}, status.Errorf(codes.InvalidArgument, "unknown auction mode %d", t.mode)
+	}
+	if t.opening < 0 || t.reserve < 0 {
+		return terms{}, status.Error(codes.InvalidArgument, "opening and reserve prices must not be negative")
+	}
+	if t.mode == pb.AuctionMode_DUTCH && (t.opening <= 0 || t.decrement <= 0 || t.interval <= 0) {
+		return terms{}, status.Error(codes.InvalidArgument, "a Dutch auction needs an opening price, a decrement and a decrement interval")
+	}
+	if (t.window != 0 || t.extension != 0) && (t.mode != pb.AuctionMode_ENGLISH || t.window <= 0 || t.extension <= 0) {
+		return terms{}, status.Error(codes.InvalidArgument, "a soft close needs an English auction, a window and an extension")
+	}
+	for i, inc := range req.Increments {
+		if inc.Step <= 0 {
+			return terms{}, status.Errorf(codes.InvalidArgument, "increment from %d must be positive", inc.From)
+		}
+		if i > 0 && inc.From <= req.Increments[i-1].From {
+			return terms{}, status.Error(codes.InvalidArgument, "increment bands must be in increasing order")
+		}
+		t.increments = append(t.increments, increment{from: inc.From, step: inc.Step})
+	}
+	return t, nil
+}
+
+// proto returns a CreateAuction request for the auction id with these
+// terms, with the start and end resolved, for replication.
+func (t terms) proto(id string, ts timestamp) *pb.CreateAuctionRequest {
+	req := &pb.CreateAuctionRequest{
+		Auction:   id,
+		Start:     timestamppb.New(t.start.time()),
+		Close:     &pb.CreateAuctionRequest_End{End: timestamppb.New(t.end.time())},
+		Timestamp: ts.proto(),
+		Opening:   t.opening,
+		Reserve:   t.reserve,
+		Mode:      t.mode,
+		Decrement: t.decrement,
+	}
+	if t.interval > 0 {
+		req.DecrementInterval = durationpb.New(t.interval)
+	}
+	if t.extension > 0 {
+		req.SoftCloseWindow = durationpb.New(t.window)
+		req.SoftCloseExtension = durationpb.New(t.extension)
+	}
+	for _, inc := range t.increments {
+		req.Increments = append(req.Increments, &pb.Increment{From: inc.from, Step: inc.step})
+	}
+	return req
+}
+
+// sealed reports whether bids are hidden until the auction closes.
+func (t terms) sealed() bool {
+	return t.mode == pb.AuctionMode_SEALED_FIRST_PRICE || t.mode == pb.AuctionMode_VICKREY
+}
+
+// auction is one node's copy of an auction.
+type auction struct {
+	terms
+	id string
+	// bids are all bids accepted by any node, in the order this node
+	// applied them, and highest is the one that beats all others.
+	bids    []bid
+	highest bid
+	// proxies are the maximum bids of bidders who bid automatically, with
+	// the maximum as the amount. If a bidder raises their maximum, the
+	// highest one is kept.
+	proxies map[string]bid
+	// history is every bid and maximum any node accepted or rejected, in
+	// the order of their timestamps.
+	history []record
+
+	// closedAt is when the auctioneer closed the auction early, if
+	// closedEarly is set. Bids from then on do not count. cancelled is set
+	// once the auctioneer has cancelled it. retracted are the positions of
+	// the bids they retracted, with the reasons.
+	closedEarly bool
+	closedAt    timestamp
+	cancelled   bool
+	retracted   map[position]string
+
+	// created is set once the auction's creation has been applied. Bids for
+	// it can arrive from other nodes before that. createdAt and createdBy
+	// order concurrent creations of the same ID: the earliest wins.
+	created   bool
+	createdAt timestamp
+	createdBy int
+
+	// paxos decides the winner of a Dutch auction.
+	paxos acceptor
+}
+
+// create applies a creation of the auction made at ts on node origin. It
+// reports whether the creation was applied, which it is unless an earlier
+// one already was.
+func (a *auction) create(t terms, ts timestamp, origin int) bool {
+	if a.created && (a.createdAt.before(ts) || (a.createdAt == ts && a.createdBy <= origin)) {
+		return false
+	}
+	a.terms = t
+	a.created, a.createdAt, a.createdBy = true, ts, origin
+	return true
+}
+
+// state returns the state of the auction at ts. A Dutch auction closes as
+// soon as a bidder accepts the price.
+func (a *auction) state(ts timestamp) pb.AuctionState {
+	switch {
+	case a.cancelled:
+		return pb.AuctionState_CANCELLED
+	case a.closedEarly && !ts.before(a.closedAt):
+		return pb.AuctionState_CLOSED
+	case a.sold():
+		return pb.AuctionState_CLOSED
+	case ts.before(a.start):
+		return pb.AuctionState_NOT_STARTED
+	case ts.before(a.closes()):
+		return pb.AuctionState_OPEN
+	default:
+		return pb.AuctionState_CLOSED
+	}
+}
+
+// closes returns when the auction closes: its end, extended by every bid
+// and maximum placed within the soft-close window of the end in force at
+// that time, taken in timestamp order. It depends only on the set of bids
+// and maximums, so all nodes that have them agree on it. Learning of more
+// bids never makes it earlier, since every end is a whole number of
+// extensions after the original one, so a node never closes an auction
+// that a bid it has not heard of yet keeps open. Retracted bids still
+// count, for the same reason. If the auctioneer closed the auction early,
+// it closes then instead.
+func (a *auction) closes() timestamp {
+	end := a.end
+	if a.extension > 0 {
+		for _, r := range a.history {
+			if r.rejected {
+				continue
+			}
+			if ts := r.timestamp; ts.before(end) && end.wall-ts.wall <= int64(a.window) {
+				end.wall += int64(a.extension)
+			}
+		}
+	}
+	if a.closedEarly && a.closedAt.before(end) {
+		return a.closedAt
+	}
+	return end
+}
+
+// logExtension logs to log if the auction now closes later than end.
+func (a *auction) logExtension(log *slog.Logger, end timestamp) {
+	if closes := a.closes(); end.before(closes) {
+		log.Info("soft close extended", "from", end, "to", closes)
+	}
+}
+
+// minimum returns the lowest amount a new bid must have: the opening price
+// for the first bid, and after that the highest bid plus the increment of
+// its price band. Sealed bids need only meet the opening price, since the
+// highest bid is not known to bidders.
+func (a *auction) minimum() int32 {
+	leading := a.leading()
+	if leading.amount == 0 || a.sealed() {
+		return max(a.opening, 1)
+	}
+	return leading.amount + a.step(leading.amount)
+}
+
+// step returns the minimum raise over a bid of amount.
+func (a *auction) step(amount int32) int32 {
+	step := int32(1)
+	for _, inc := range a.increments {
+		if amount >= inc.from {
+			step = inc.step
+		}
+	}
+	return step
+}
+
+// leading returns the bid that currently wins the auction. Without
+// automatic bidding it is the highest bid. Otherwise the bidder prepared
+// to pay the most leads, either with their own highest bid or, if their
+// maximum is higher, with an automatic bid of one increment over the best
+// other bidder's maximum, and at least the reserve, or of all of their
+// maximum if that is less. On a tie the earlier bid or maximum wins. It depends only on the set of bids
+// and maximums, so all nodes that have them agree on it.
+func (a *auction) leading() bid {
+	if len(a.proxies) == 0 {
+		return a.highest
+	}
+
+	best := a.highest
+	for _, p := range a.proxies {
+		if p.beats(best) {
+			best = p
+		}
+	}
+	if p, ok := a.proxies[best.bidder]; !ok || p != best {
+		return best
+	}
+
+	var second bid
+	candidates := []bid{a.highest}
+	for _, p := range a.proxies {
+		candidates = append(candidates, p)
+	}
+	for _, c := range candidates {
+		if c.bidder != best.bidder && c.beats(second) {
+			second = c
+		}
+	}
+
+	amount := max(a.opening, a.reserve, 1)
+	if a.highest.bidder == best.bidder {
+		amount = max(amount, a.highest.amount)
+	}
+	if second.amount > 0 {
+		amount = max(amount, second.amount+a.step(second.amount))
+	}
+	leading := best
+	leading.amount = min(amount, best.amount)
+	return leading
+}
+
+// reserveMet reports whether the leading bid would win if the auction
+// closed now.
+func (a *auction) reserveMet() bool {
+	return a.leading().amount >= a.reserve
+}
+
+// price returns what the highest bidder pays: their bid, or in a Vickrey
+// auction the highest bid of anyone else, but no less than the reserve or
+// opening price.
+func (a *auction) price() int32 {
+	if a.mode != pb.AuctionMode_VICKREY {
+		return a.leading().amount
+	}
+	price := max(a.reserve, a.opening, 1)
+	for _, b := range a.bids {
+		if b.bidder != a.highest.bidder {
+			price = max(price, b.amount)
+		}
+	}
+	return min(price, a.highest.amount)
+}
+
+func (a *auction) String() string {
+	if a.id == defaultAuction {
+		return "the default auction"
+	}
+	return fmt.Sprintf("auction %q", a.id)
+}
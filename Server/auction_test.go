@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSoftClose(t *testing.T) {
+	end := timestamp{wall: int64(time.Hour)}
+	at := func(d time.Duration) record {
+		return record{bid: bid{timestamp: timestamp{wall: end.wall + int64(d)}}}
+	}
+	a := &auction{terms: terms{end: end, window: 10 * time.Second, extension: 30 * time.Second}}
+
+	tests := []struct {
+		name    string
+		history []record
+		want    time.Duration
+	}{
+		{"no bids", nil, 0},
+		{"bid before the window", []record{at(-time.Minute)}, 0},
+		{"bid in the window", []record{at(-5 * time.Second)}, 30 * time.Second},
+		{"rejected bid in the window", []record{{bid: at(-5 * time.Second).bid, rejected: true}}, 0},
+		// The first bid moves the end to +30s, which puts the second one in
+		// the window of the new end.
+		{"bids in successive windows", []record{at(-5 * time.Second), at(25 * time.Second)}, time.Minute},
+		{"bid after the end", []record{at(time.Second)}, 0},
+	}
+	for _, tt := range tests {
+		a.history = tt.history
+		if got, want := a.closes(), (timestamp{wall: end.wall + int64(tt.want)}); got != want {
+			t.Errorf("%s: closes() = %v, want %v", tt.name, time.Duration(got.wall-end.wall), tt.want)
+		}
+	}
+
+	a.history = []record{at(-5 * time.Second)}
+	a.closedEarly, a.closedAt = true, timestamp{wall: end.wall - int64(time.Minute)}
+	if got := a.closes(); got != a.closedAt {
+		t.Errorf("closes() after an early close = %v, want %v", got, a.closedAt)
+	}
+}
+
+func TestMinimum(t *testing.T) {
+	a := &auction{terms: terms{opening: 10, increments: []increment{{from: 0, step: 1}, {from: 100, step: 5}, {from: 1000, step: 50}}}}
+
+	tests := []struct {
+		highest int32
+		want    int32
+	}{
+		{0, 10},
+		{10, 11},
+		{99, 100},
+		{100, 105},
+		{999, 1004},
+		{1000, 1050},
+	}
+	for _, tt := range tests {
+		a.highest = bid{bidder: "Alice", amount: tt.highest}
+		if got := a.minimum(); got != tt.want {
+			t.Errorf("minimum() with highest bid %d = %d, want %d", tt.highest, got, tt.want)
+		}
+	}
+}
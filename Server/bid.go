@@ -0,0 +1,28 @@
+package main
+
+// bid is an accepted bid together with what is needed to order it against
+// bids accepted concurrently on other nodes.
+type bid struct {
+	bidder    string
+	amount    int32
+	timestamp timestamp
+	nodeID    int
+}
+
+// beats reports whether b wins over o. Bids are totally ordered by amount
+// (higher wins), then by hybrid logical clock timestamp (earlier wins), then
+// by the ID of the node that accepted them and finally by bidder name (lower
+// wins). Every replica applies the same order, so they all agree on the
+// winner whatever order concurrent bids are delivered in.
+func (b bid) beats(o bid) bool {
+	if b.amount != o.amount {
+		return b.amount > o.amount
+	}
+	if b.timestamp != o.timestamp {
+		return b.timestamp.before(o.timestamp)
+	}
+	if b.nodeID != o.nodeID {
+		return b.nodeID < o.nodeID
+	}
+	return b.bidder < o.bidder
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// record is an entry in the bid history of an auction: a bid, or a maximum
+// if automatic is set, that node nodeID accepted or rejected.
+type record struct {
+	bid
+	automatic bool
+	rejected  bool
+	reason    string
+}
+
+// proto returns r for ListBids, without its outcome, which depends on the
+// auction.
+func (r record) proto() *pb.BidRecord {
+	return &pb.BidRecord{
+		Bidder:    r.bidder,
+		Amount:    r.amount,
+		Automatic: r.automatic,
+		Timestamp: r.timestamp.proto(),
+		Node:      int32(r.nodeID),
+		Id:        r.position().String(),
+	}
+}
+
+// position orders records by timestamp, then by node. A node gives every
+// bid it receives its own timestamp, so no two records share a position.
+type position struct {
+	ts     timestamp
+	nodeID int
+}
+
+func (p position) compare(o position) int {
+	switch {
+	case p.ts.before(o.ts):
+		return -1
+	case o.ts.before(p.ts):
+		return 1
+	}
+	return p.nodeID - o.nodeID
+}
+
+func (r record) position() position {
+	return position{ts: r.timestamp, nodeID: r.nodeID}
+}
+
+// String encodes p as a page token, which also serves as the ID of the
+// record at p.
+func (p position) String() string {
+	return fmt.Sprintf("%d.%d.%d", p.ts.wall, p.ts.logical, p.nodeID)
+}
+
+func parsePosition(s string) (position, error) {
+	var p position
+	if _, err := fmt.Sscanf(s, "%d.%d.%d", &p.ts.wall, &p.ts.logical, &p.nodeID); err != nil {
+		return position{}, err
+	}
+	return p, nil
+}
+
+// record adds r to the history of the auction, in position order, so that
+// all nodes list the same history in the same order however the records
+// reached them. It ignores a record it already has.
+func (a *auction) record(r record) {
+	i, found := slices.BinarySearchFunc(a.history, r.position(), func(h record, p position) int {
+		return h.position().compare(p)
+	})
+	if !found {
+		a.history = slices.Insert(a.history, i, r)
+	}
+}
+
+// view returns r as bidders may see it, with its outcome. Like Result, it
+// does not give away how far a bidder will go before the auction closes.
+func (a *auction) view(r record, closed bool) *pb.BidRecord {
+	rec := r.proto()
+	rec.Outcome, rec.Reason = a.outcome(r)
+	if r.automatic && !closed {
+		rec.Amount = 0
+	}
+	return rec
+}
+
+// reject records that this node rejected the bid req in the auction a for
+// reason, replicates the rejection and returns the response to the bidder.
+// s.mu must be held.
+func (s *AuctionServer) reject(ctx context.Context, a *auction, req *pb.BidRequest, ts timestamp, reason string) *pb.BidResponse {
+	s.logger(ctx).Info("bid rejected", "auction", a.id, "bidder", req.Bidder, "amount", req.Amount, "max_amount", req.MaxAmount, "ts", ts, "reason", reason)
+	r := rejection(req, ts, s.nodeID, reason)
+	a.record(r)
+	s.publishRecord(a, r)
+	countBid(a, "rejected")
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: ts.proto(),
+		Op: &pb.ReplicateRequest_Rejection{Rejection: &pb.Rejection{
+			Bid: &pb.BidRequest{
+				Bidder:    req.Bidder,
+				Amount:    req.Amount,
+				MaxAmount: req.MaxAmount,
+				Timestamp: ts.proto(),
+				Auction:   req.Auction,
+			},
+			Reason: reason,
+		}},
+	})
+	return &pb.BidResponse{Message: "fail", Timestamp: ts.proto(), Reason: reason}
+}
+
+// rejection returns the record of the bid req that node rejected at ts.
+func rejection(req *pb.BidRequest, ts timestamp, node int, reason string) record {
+	r := record{bid: bid{bidder: req.Bidder, amount: req.Amount, timestamp: ts, nodeID: node}, rejected: true, reason: reason}
+	if req.MaxAmount != 0 {
+		r.amount, r.automatic = req.MaxAmount, true
+	}
+	return r
+}
+
+// ListBids returns a page of the bid history of an auction. Every node
+// keeps the whole history, rejected bids included, so any node can answer.
+// The auctioneer may list sealed bids before the auction closes.
+func (s *AuctionServer) ListBids(ctx context.Context, req *pb.ListBidsRequest) (*pb.ListBidsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts := s.hlc.tick()
+	a, err := s.lookup(req.Auction)
+	if err != nil {
+		return nil, err
+	}
+	closed := a.state(ts) == pb.AuctionState_CLOSED
+	if a.sealed() && !closed && s.authorize(ctx) != nil {
+		return nil, status.Error(codes.FailedPrecondition, "bids are sealed until the auction closes")
+	}
+
+	size := int(req.PageSize)
+	switch {
+	case size <= 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	start := 0
+	if req.PageToken != "" {
+		after, err := parsePosition(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", req.PageToken)
+		}
+		start, _ = slices.BinarySearchFunc(a.history, after, func(h record, p position) int {
+			if h.position().compare(p) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+
+	resp := &pb.ListBidsResponse{Timestamp: ts.proto()}
+	var last position
+	for _, r := range a.history[start:] {
+		if len(resp.Bids) == size {
+			resp.NextPageToken = last.String()
+			break
+		}
+		outcome, _ := a.outcome(r)
+		if (req.Bidder != "" && r.bidder != req.Bidder) ||
+			(req.From != nil && r.timestamp.wall < req.From.AsTime().UnixNano()) ||
+			(req.To != nil && r.timestamp.wall >= req.To.AsTime().UnixNano()) ||
+			(req.Outcome != nil && outcome != *req.Outcome) {
+			continue
+		}
+		resp.Bids = append(resp.Bids, a.view(r, closed))
+		last = r.position()
+	}
+	return resp, nil
+}
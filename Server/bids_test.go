@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestListBidsPagination(t *testing.T) {
+	clk := clock.NewFake(time.Unix(100, 0))
+	s := newAuctionServer(1, clk)
+	ctx := context.Background()
+
+	// Seven bids, two of them rejected.
+	for _, amount := range []int32{1, 2, 2, 3, 4, 1, 5} {
+		if _, err := s.Bid(ctx, &pb.BidRequest{Bidder: "Alice", Amount: amount}); err != nil {
+			t.Fatal(err)
+		}
+		clk.Advance(time.Millisecond)
+	}
+
+	var all []*pb.BidRecord
+	token := ""
+	for pages := 1; ; pages++ {
+		resp, err := s.ListBids(ctx, &pb.ListBidsRequest{PageSize: 3, PageToken: token})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Bids) > 3 {
+			t.Fatalf("page %d has %d bids, want at most 3", pages, len(resp.Bids))
+		}
+		all = append(all, resp.Bids...)
+		if token = resp.NextPageToken; token == "" {
+			break
+		}
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+	}
+
+	var amounts []int32
+	ids := map[string]bool{}
+	for i, r := range all {
+		amounts = append(amounts, r.Amount)
+		if ids[r.Id] {
+			t.Errorf("bid %s listed twice", r.Id)
+		}
+		ids[r.Id] = true
+		if i > 0 && !fromProto(all[i-1].Timestamp).before(fromProto(r.Timestamp)) {
+			t.Errorf("bid %d is listed before an older one", i)
+		}
+	}
+	if want := []int32{1, 2, 2, 3, 4, 1, 5}; !slices.Equal(amounts, want) {
+		t.Errorf("listed amounts %v, want %v", amounts, want)
+	}
+
+	rejected := pb.BidOutcome_REJECTED
+	resp, err := s.ListBids(ctx, &pb.ListBidsRequest{Outcome: &rejected})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Bids) != 2 || resp.Bids[0].Amount != 2 || resp.Bids[1].Amount != 1 {
+		t.Errorf("rejected bids = %v, want the second 2 and the last 1", resp.Bids)
+	}
+
+	if _, err := s.ListBids(ctx, &pb.ListBidsRequest{PageToken: "junk"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListBids with a junk token: %v, want InvalidArgument", err)
+	}
+}
+
+func TestAutomaticBidsMeetReserve(t *testing.T) {
+	tests := []struct {
+		name       string
+		maximums   map[string]int32
+		wantResult string
+	}{
+		{"lone maximum above the reserve", map[string]int32{"Alice": 500}, "Auction over. Winner: Alice with bid 200"},
+		{"two maximums above the reserve", map[string]int32{"Alice": 500, "Bob": 300}, "Auction over. Winner: Alice with bid 301"},
+		{"lone maximum below the reserve", map[string]int32{"Alice": 150}, "Auction over. Reserve of 200 not met, no winner"},
+	}
+	for _, tt := range tests {
+		clk := clock.NewFake(time.Unix(100, 0))
+		s := newAuctionServer(1, clk)
+		ctx := context.Background()
+		if _, err := s.CreateAuction(ctx, &pb.CreateAuctionRequest{
+			Auction: "lot",
+			Reserve: 200,
+			Close:   &pb.CreateAuctionRequest_Duration{Duration: durationpb.New(time.Minute)},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		for _, bidder := range slices.Sorted(maps.Keys(tt.maximums)) {
+			if resp, err := s.Bid(ctx, &pb.BidRequest{Auction: "lot", Bidder: bidder, MaxAmount: tt.maximums[bidder]}); err != nil || resp.Message != "success" {
+				t.Fatalf("%s: Bid(%s, max %d) = %v, %v", tt.name, bidder, tt.maximums[bidder], resp, err)
+			}
+			clk.Advance(time.Millisecond)
+		}
+
+		clk.Advance(time.Hour)
+		resp, err := s.Result(ctx, &pb.ResultRequest{Auction: "lot"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Highestbid != tt.wantResult {
+			t.Errorf("%s: Result = %q, want %q", tt.name, resp.Highestbid, tt.wantResult)
+		}
+	}
+}
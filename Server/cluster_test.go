@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Cluster runs a set of AuctionServers in one process, connected to each
+// other over in-memory bufconn listeners instead of TCP, so replication and
+// failover can be exercised by go test. Every call between two endpoints
+// passes through the cluster, which can crash and restart nodes and drop,
+// delay or partition the traffic on chosen links.
+//
+// A typical test:
+//
+//	c, err := NewCluster(3, t.TempDir(), clock.Real)
+//	...
+//	defer c.Close()
+//	c.Client(1).Bid(ctx, &pb.BidRequest{Bidder: "Alice", Amount: 5})
+//	c.Crash(1)
+//	c.Client(2).Result(ctx, &pb.ResultRequest{})
+type Cluster struct {
+	dir   string
+	size  int
+	clock clock.Clock
+
+	mu      sync.Mutex
+	nodes   map[int]*clusterNode
+	links   map[link]linkFaults
+	rand    *rand.Rand
+	clients map[int]*grpc.ClientConn
+}
+
+type clusterNode struct {
+	lis        *bufconn.Listener
+	grpcServer *grpc.Server
+	server     *AuctionServer
+}
+
+// linkFaults are the faults on a link. dropRate is the probability that a
+// call is lost, and responseDropRate the probability that it is applied but
+// its response is lost.
+type linkFaults struct {
+	dropRate         float64
+	responseDropRate float64
+	delay            time.Duration
+	partitioned      bool
+}
+
+// NewCluster starts n nodes with IDs 1 to n, all reading time from clk.
+// Each node keeps its files in its own directory below dir, where they
+// survive a crash.
+func NewCluster(n int, dir string, clk clock.Clock) (*Cluster, error) {
+	c := &Cluster{
+		dir:     dir,
+		size:    n,
+		clock:   clk,
+		nodes:   map[int]*clusterNode{},
+		links:   map[link]linkFaults{},
+		rand:    rand.New(rand.NewSource(1)),
+		clients: map[int]*grpc.ClientConn{},
+	}
+	for id := 1; id <= n; id++ {
+		if err := c.start(id); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *Cluster) start(id int) error {
+	dir := filepath.Join(c.dir, fmt.Sprintf("node%d", id))
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+
+	node := &clusterNode{
+		lis:        bufconn.Listen(1 << 20),
+		grpcServer: grpc.NewServer(grpc.ChainUnaryInterceptor(c.serverInterceptor(id))),
+		server:     NewAuctionServer(id, c.clock),
+	}
+	epoch, err := nextIncarnation(filepath.Join(dir, "incarnation"))
+	if err != nil {
+		node.server.Stop()
+		return err
+	}
+	node.server.epoch = epoch
+	node.server.clusterSecret = clusterSecret
+	if err := node.server.openAcceptors(filepath.Join(dir, "paxos.log")); err != nil {
+		node.server.Stop()
+		return err
+	}
+	if err := node.server.openState(filepath.Join(dir, "state.log")); err != nil {
+		node.server.Stop()
+		return err
+	}
+	for peer := 1; peer <= c.size; peer++ {
+		if peer == id {
+			continue
+		}
+		hintPath := filepath.Join(dir, fmt.Sprintf("hints-%d-%d.log", id, peer))
+		markPath := filepath.Join(dir, fmt.Sprintf("delivered-%d-%d", id, peer))
+		if err := node.server.addPeer(peer, target(peer), hintPath, markPath, c.dialOptions(id)...); err != nil {
+			node.server.Stop()
+			return err
+		}
+	}
+	node.server.register(node.grpcServer)
+	go node.grpcServer.Serve(node.lis)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nodes[id] = node
+	return nil
+}
+
+// Server returns the AuctionServer currently running as node id, or nil if
+// the node is crashed.
+func (c *Cluster) Server(id int) *AuctionServer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if node, ok := c.nodes[id]; ok {
+		return node.server
+	}
+	return nil
+}
+
+// Client returns a client connected to node id. Calls from it are subject
+// to the faults on the links between ClientID and id.
+func (c *Cluster) Client(id int) pb.AuctionClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	conn, ok := c.clients[id]
+	if !ok {
+		var err error
+		if conn, err = grpc.NewClient(target(id), c.dialOptions(ClientID)...); err != nil {
+			// NewClient only fails on malformed options.
+			panic(err)
+		}
+		c.clients[id] = conn
+	}
+	return pb.NewAuctionClient(conn)
+}
+
+// Crash stops node id abruptly. Its in-memory state is lost, but its files
+// are kept for Restart.
+func (c *Cluster) Crash(id int) {
+	c.mu.Lock()
+	node, ok := c.nodes[id]
+	delete(c.nodes, id)
+	c.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	node.grpcServer.Stop()
+	node.server.Stop()
+}
+
+// Shutdown stops node id the way a SIGTERM would, giving it until ctx is
+// done to flush its replication.
+func (c *Cluster) Shutdown(ctx context.Context, id int) {
+	c.mu.Lock()
+	node, ok := c.nodes[id]
+	c.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	node.server.Shutdown(ctx, node.grpcServer)
+	c.Crash(id)
+}
+
+// Restart starts a fresh AuctionServer as node id after a Crash or a
+// Shutdown.
+func (c *Cluster) Restart(id int) error {
+	c.Crash(id)
+	return c.start(id)
+}
+
+// Drop makes calls from one endpoint to another fail with the given
+// probability. Half of the failed calls are lost on the way to the called
+// node, and the other half are applied there but lose their response, so
+// the caller cannot tell whether the call took effect.
+func (c *Cluster) Drop(from, to int, rate float64) {
+	c.update(from, to, func(f *linkFaults) { f.dropRate, f.responseDropRate = rate/2, rate/2 })
+}
+
+// DropResponses makes calls from one endpoint to another lose their
+// response with the given probability, after the called node has applied
+// them.
+func (c *Cluster) DropResponses(from, to int, rate float64) {
+	c.update(from, to, func(f *linkFaults) { f.dropRate, f.responseDropRate = 0, rate })
+}
+
+// Delay holds every call from one endpoint to another for d before it is
+// delivered.
+func (c *Cluster) Delay(from, to int, d time.Duration) {
+	c.update(from, to, func(f *linkFaults) { f.delay = d })
+}
+
+// Partition cuts every link between endpoints in different groups, in both
+// directions. Endpoints not named in any group are unaffected.
+func (c *Cluster) Partition(groups ...[]int) {
+	for i, a := range groups {
+		for _, b := range groups[i+1:] {
+			for _, from := range a {
+				for _, to := range b {
+					c.update(from, to, func(f *linkFaults) { f.partitioned = true })
+					c.update(to, from, func(f *linkFaults) { f.partitioned = true })
+				}
+			}
+		}
+	}
+}
+
+// Heal removes every fault from every link.
+func (c *Cluster) Heal() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.links = map[link]linkFaults{}
+}
+
+// Close stops all nodes and client connections.
+func (c *Cluster) Close() {
+	c.mu.Lock()
+	ids := make([]int, 0, len(c.nodes))
+	for id := range c.nodes {
+		ids = append(ids, id)
+	}
+	clients := c.clients
+	c.clients = nil
+	c.mu.Unlock()
+
+	for _, id := range ids {
+		c.Crash(id)
+	}
+	for _, conn := range clients {
+		conn.Close()
+	}
+}
+
+func (c *Cluster) update(from, to int, change func(*linkFaults)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f := c.links[link{from, to}]
+	change(&f)
+	c.links[link{from, to}] = f
+}
+
+// deliver applies the faults on the link from one endpoint to another. It
+// returns an error if the call does not get through, and otherwise reports
+// whether its response is to be lost.
+func (c *Cluster) deliver(ctx context.Context, from, to int) (bool, error) {
+	c.mu.Lock()
+	f := c.links[link{from, to}]
+	r := c.rand.Float64()
+	dropped := f.partitioned || r < f.dropRate
+	loseResponse := !dropped && r < f.dropRate+f.responseDropRate
+	_, up := c.nodes[to]
+	c.mu.Unlock()
+
+	if f.delay > 0 {
+		select {
+		case <-c.clock.After(f.delay):
+		case <-ctx.Done():
+			return false, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if dropped || !up {
+		return false, status.Errorf(codes.Unavailable, "cluster: node %d unreachable from %d", to, from)
+	}
+	return loseResponse, nil
+}
+
+func (c *Cluster) dialOptions(from int) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			var to int
+			if _, err := fmt.Sscanf(addr, "node%d", &to); err != nil {
+				return nil, err
+			}
+			c.mu.Lock()
+			node, ok := c.nodes[to]
+			c.mu.Unlock()
+			if !ok {
+				return nil, fmt.Errorf("cluster: node %d is down", to)
+			}
+			return node.lis.DialContext(ctx)
+		}),
+		grpc.WithChainUnaryInterceptor(c.clientInterceptor(from)),
+	}
+}
+
+// clientInterceptor applies the faults on the link to the called node.
+func (c *Cluster) clientInterceptor(from int) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var to int
+		if _, err := fmt.Sscanf(cc.Target(), "passthrough:///node%d", &to); err != nil {
+			return err
+		}
+		loseResponse, err := c.deliver(ctx, from, to)
+		if err != nil {
+			return err
+		}
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return err
+		}
+		if loseResponse {
+			return status.Errorf(codes.Unavailable, "cluster: response from node %d to %d lost", to, from)
+		}
+		return nil
+	}
+}
+
+// serverInterceptor rejects calls that reach a node after it has crashed,
+// which can happen while grpc.Server.Stop is still closing connections.
+func (c *Cluster) serverInterceptor(id int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		c.mu.Lock()
+		_, up := c.nodes[id]
+		c.mu.Unlock()
+		if !up {
+			return nil, status.Errorf(codes.Unavailable, "cluster: node %d is down", id)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func target(id int) string {
+	return fmt.Sprintf("passthrough:///node%d", id)
+}
+
+// newCluster starts a cluster of n nodes for the test t and closes it when
+// the test ends.
+func newCluster(t *testing.T, n int, clk clock.Clock) *Cluster {
+	t.Helper()
+	c, err := NewCluster(n, t.TempDir(), clk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Close)
+	return c
+}
+
+// eventually fails the test t unless cond holds within five seconds.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting until %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// highest returns the highest bid node id reports for the default auction,
+// or "" if it cannot be reached.
+func (c *Cluster) highest(id int) string {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := c.Client(id).Result(ctx, &pb.ResultRequest{})
+	if err != nil {
+		return ""
+	}
+	return resp.Highestbid
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+)
+
+// deliveryMark records how far the messages this node replicated have been
+// handed over to a peer: every one up to the mark was either delivered or
+// stored as a hint. On a restart, the messages in the state log after the
+// mark are sent again. The mark is written without syncing the file: if a
+// crash loses the last write, the node only sends a few messages again,
+// which the peer ignores.
+type deliveryMark struct {
+	mu   sync.Mutex
+	file *os.File
+	last appliedSeq
+}
+
+// deliveryMarkSize is the size of a mark on disk: the epoch and sequence
+// number, and their CRC-32.
+const deliveryMarkSize = 20
+
+// openDeliveryMark loads the mark left in path by a previous run. A missing
+// or torn mark reads as nothing handed over yet.
+func openDeliveryMark(path string) (*deliveryMark, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+	m := &deliveryMark{file: f}
+	var buf [deliveryMarkSize]byte
+	if _, err := io.ReadFull(f, buf[:]); err == nil && crc32.ChecksumIEEE(buf[:16]) == binary.BigEndian.Uint32(buf[16:]) {
+		m.last = appliedSeq{epoch: int64(binary.BigEndian.Uint64(buf[:8])), seq: int64(binary.BigEndian.Uint64(buf[8:16]))}
+	}
+	return m, nil
+}
+
+// covers reports whether msg is at or before the mark.
+func (m *deliveryMark) covers(msg *pb.ReplicateRequest) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return msg.Epoch < m.last.epoch || (msg.Epoch == m.last.epoch && msg.Seq <= m.last.seq)
+}
+
+// advance moves the mark to msg, which has been handed over along with
+// every message before it.
+func (m *deliveryMark) advance(msg *pb.ReplicateRequest) error {
+	if m.covers(msg) {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.last = appliedSeq{epoch: msg.Epoch, seq: msg.Seq}
+	var buf [deliveryMarkSize]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(msg.Epoch))
+	binary.BigEndian.PutUint64(buf[8:16], uint64(msg.Seq))
+	binary.BigEndian.PutUint32(buf[16:], crc32.ChecksumIEEE(buf[:16]))
+	_, err := m.file.WriteAt(buf[:], 0)
+	return err
+}
+
+func (m *deliveryMark) close() error {
+	return m.file.Close()
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/logging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// proposeAttempts is how many ballots a node tries before it gives up on
+// getting an acceptance decided.
+const proposeAttempts = 5
+
+// acceptance is the acceptance of the price of a Dutch auction by bidder,
+// made on node origin at ts.
+type acceptance struct {
+	bidder string
+	origin int
+	ts     timestamp
+}
+
+func acceptanceFromProto(a *pb.Acceptance) *acceptance {
+	if a == nil {
+		return nil
+	}
+	return &acceptance{bidder: a.Bidder, origin: int(a.Origin), ts: fromProto(a.Accepted)}
+}
+
+func (a acceptance) proto() *pb.Acceptance {
+	return &pb.Acceptance{Bidder: a.bidder, Origin: int32(a.origin), Accepted: a.ts.proto()}
+}
+
+// ballot numbers a Paxos proposal.
+type ballot struct {
+	round int64
+	node  int
+}
+
+func (b ballot) less(o ballot) bool {
+	if b.round != o.round {
+		return b.round < o.round
+	}
+	return b.node < o.node
+}
+
+func ballotFromProto(b *pb.Ballot) ballot {
+	return ballot{round: b.GetRound(), node: int(b.GetNode())}
+}
+
+func (b ballot) proto() *pb.Ballot {
+	return &pb.Ballot{Round: b.round, Node: int32(b.node)}
+}
+
+// acceptor is a node's Paxos acceptor state for the winner of a Dutch
+// auction.
+type acceptor struct {
+	promised       ballot
+	acceptedBallot ballot
+	accepted       *acceptance
+}
+
+// prepare promises to ignore ballots lower than b, if no higher ballot has
+// been promised already.
+func (p *acceptor) prepare(b ballot) bool {
+	if b.less(p.promised) {
+		return false
+	}
+	p.promised = b
+	return true
+}
+
+// accept accepts v in ballot b, unless a higher ballot has been promised.
+func (p *acceptor) accept(b ballot, v acceptance) bool {
+	if b.less(p.promised) {
+		return false
+	}
+	p.promised, p.acceptedBallot, p.accepted = b, b, &v
+	return true
+}
+
+// priceAt returns the price of a Dutch auction at ts. It depends only on
+// the terms, which every node has, and on the timestamp, so all nodes agree
+// on the price at any point of the hybrid logical clock.
+func (t terms) priceAt(ts timestamp) int32 {
+	steps := max(ts.wall-t.start.wall, 0) / int64(t.interval)
+	price := int64(t.opening) - steps*int64(t.decrement)
+	return int32(max(price, int64(t.reserve), 1))
+}
+
+// sold reports whether a bidder has won the Dutch auction.
+func (a *auction) sold() bool {
+	return a.mode == pb.AuctionMode_DUTCH && a.highest.amount > 0
+}
+
+// acceptDutch lets the bidder of req accept the price of the Dutch auction
+// a at ts. Which acceptance wins is decided by Paxos: this node proposes
+// the bidder's acceptance, but adopts any acceptance a majority of nodes
+// may already have chosen. So even when several nodes receive acceptances
+// at the same time, exactly one wins everywhere. Competing proposers retry
+// after a random backoff. s.mu must be held; it is released while waiting
+// for other nodes.
+func (s *AuctionServer) acceptDutch(ctx context.Context, a *auction, req *pb.BidRequest, ts timestamp) *pb.BidResponse {
+	if price := a.priceAt(ts); req.Amount < price {
+		return s.reject(ctx, a, req, ts, fmt.Sprintf("price is %d", price))
+	}
+
+	own := acceptance{bidder: req.Bidder, origin: s.nodeID, ts: ts}
+	nodes := s.nodes
+	quorum := (len(nodes)+1)/2 + 1
+	round := a.paxos.promised.round
+	for attempt := 1; attempt <= proposeAttempts && !a.sold(); attempt++ {
+		b := ballot{round: round + 1, node: s.nodeID}
+
+		// Phase 1: get a majority to promise b, and learn what they may
+		// have accepted already.
+		promises := 0
+		var value *acceptance
+		var valueBallot ballot
+		if ok, err := s.updateAcceptor(a, func(p *acceptor) bool { return p.prepare(b) }); err != nil {
+			s.logger(ctx).Error("failed to save the promise", "auction", a.id, "err", err)
+		} else if ok {
+			promises++
+			value, valueBallot = a.paxos.accepted, a.paxos.acceptedBallot
+		}
+		s.mu.Unlock()
+		responses := s.prepare(ctx, nodes, &pb.PrepareRequest{Auction: a.id, Ballot: b.proto()})
+		s.mu.Lock()
+		for _, resp := range responses {
+			round = max(round, resp.PromisedBallot.GetRound())
+			if !resp.Promised {
+				continue
+			}
+			promises++
+			if accepted := acceptanceFromProto(resp.Accepted); accepted != nil && (value == nil || valueBallot.less(ballotFromProto(resp.AcceptedBallot))) {
+				value, valueBallot = accepted, ballotFromProto(resp.AcceptedBallot)
+			}
+		}
+
+		if promises >= quorum {
+			if value == nil {
+				value = &own
+			}
+
+			// Phase 2: get a majority to accept the value in b.
+			accepts := 0
+			v := *value
+			if ok, err := s.updateAcceptor(a, func(p *acceptor) bool { return p.accept(b, v) }); err != nil {
+				s.logger(ctx).Error("failed to save the acceptance", "auction", a.id, "err", err)
+			} else if ok {
+				accepts++
+			}
+			s.mu.Unlock()
+			responses := s.accept(ctx, nodes, &pb.AcceptRequest{Auction: a.id, Ballot: b.proto(), Acceptance: value.proto()})
+			s.mu.Lock()
+			for _, resp := range responses {
+				round = max(round, resp.PromisedBallot.GetRound())
+				if resp.Accepted {
+					accepts++
+				}
+			}
+			if accepts >= quorum {
+				s.decide(ctx, a, *value)
+				break
+			}
+		}
+
+		s.logger(ctx).Info("ballot failed", "auction", a.id, "bidder", req.Bidder, "round", b.round, "proposer", b.node, "promises", promises)
+		backoff := time.Duration(s.rand.Intn(50*attempt)) * time.Millisecond
+		s.mu.Unlock()
+		select {
+		case <-s.clock.After(backoff):
+		case <-ctx.Done():
+		}
+		s.mu.Lock()
+		round = max(round, a.paxos.promised.round)
+	}
+
+	now := s.hlc.tick()
+	if a.sold() && a.highest.bidder == own.bidder && a.highest.timestamp == own.ts {
+		countBid(a, "accepted")
+		return &pb.BidResponse{Message: "success", Timestamp: now.proto()}
+	}
+	reason := "no acceptance was decided"
+	if a.sold() {
+		reason = a.highest.bidder + " won"
+	}
+	resp := s.reject(ctx, a, req, ts, reason)
+	resp.Timestamp = now.proto()
+	return resp
+}
+
+// decide makes the chosen acceptance v the winning bid of the Dutch auction
+// a and replicates it, so nodes that took no part in the vote learn it.
+// s.mu must be held.
+func (s *AuctionServer) decide(ctx context.Context, a *auction, v acceptance) {
+	if a.sold() {
+		return
+	}
+	price := a.priceAt(v.ts)
+	s.applyBid(ctx, a, bid{bidder: v.bidder, amount: price, timestamp: v.ts, nodeID: v.origin})
+	s.replicate(ctx, &pb.ReplicateRequest{
+		Timestamp: s.hlc.tick().proto(),
+		Op: &pb.ReplicateRequest_Bid{Bid: &pb.BidRequest{
+			Bidder:    v.bidder,
+			Amount:    price,
+			Timestamp: v.ts.proto(),
+			Auction:   a.id,
+		}},
+	})
+}
+
+// prepare sends req to the active nodes and returns their responses.
+func (s *AuctionServer) prepare(ctx context.Context, nodes []*Node, req *pb.PrepareRequest) []*pb.PrepareResponse {
+	return broadcast(ctx, nodes, func(ctx context.Context, node *Node) (*pb.PrepareResponse, error) {
+		req := &pb.PrepareRequest{Auction: req.Auction, Ballot: req.Ballot, Timestamp: s.hlc.tick().proto()}
+		resp, err := node.client.Prepare(ctx, req)
+		if err == nil {
+			node.merge(resp.Timestamp)
+		}
+		return resp, err
+	})
+}
+
+// accept sends req to the active nodes and returns their responses.
+func (s *AuctionServer) accept(ctx context.Context, nodes []*Node, req *pb.AcceptRequest) []*pb.AcceptResponse {
+	return broadcast(ctx, nodes, func(ctx context.Context, node *Node) (*pb.AcceptResponse, error) {
+		req := &pb.AcceptRequest{Auction: req.Auction, Ballot: req.Ballot, Acceptance: req.Acceptance, Timestamp: s.hlc.tick().proto()}
+		resp, err := node.client.Accept(ctx, req)
+		if err == nil {
+			node.merge(resp.Timestamp)
+		}
+		return resp, err
+	})
+}
+
+// broadcast calls call on every active node in parallel and returns the
+// responses of those that answered within two seconds.
+func broadcast[R any](ctx context.Context, nodes []*Node, call func(context.Context, *Node) (R, error)) []R {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	var mu sync.Mutex
+	var responses []R
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		if !node.active.Load() {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := call(ctx, node)
+			if err != nil {
+				logging.Logger(ctx, node.log).Warn("call failed", "err", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			responses = append(responses, resp)
+		}()
+	}
+	wg.Wait()
+	return responses
+}
+
+// Prepare is phase 1 of Paxos for the winner of a Dutch auction.
+func (s *peerServer) Prepare(ctx context.Context, req *pb.PrepareRequest) (*pb.PrepareResponse, error) {
+	if err := s.authenticatePeer(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, err := s.hlc.update(fromProto(req.Timestamp))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	a := s.auction(req.Auction)
+	promised, err := s.updateAcceptor(a, func(p *acceptor) bool { return p.prepare(ballotFromProto(req.Ballot)) })
+	if err != nil {
+		s.logger(ctx).Error("failed to save the promise", "auction", a.id, "err", err)
+		return nil, status.Error(codes.Internal, "failed to save the promise")
+	}
+	p := &a.paxos
+	resp := &pb.PrepareResponse{Promised: promised, PromisedBallot: p.promised.proto(), Timestamp: ts.proto()}
+	if p.accepted != nil {
+		resp.AcceptedBallot, resp.Accepted = p.acceptedBallot.proto(), p.accepted.proto()
+	}
+	return resp, nil
+}
+
+// Accept is phase 2 of Paxos for the winner of a Dutch auction.
+func (s *peerServer) Accept(ctx context.Context, req *pb.AcceptRequest) (*pb.AcceptResponse, error) {
+	if err := s.authenticatePeer(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, err := s.hlc.update(fromProto(req.Timestamp))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if req.Acceptance == nil {
+		return nil, status.Error(codes.InvalidArgument, "acceptance is required")
+	}
+	a := s.auction(req.Auction)
+	v := *acceptanceFromProto(req.Acceptance)
+	accepted, err := s.updateAcceptor(a, func(p *acceptor) bool { return p.accept(ballotFromProto(req.Ballot), v) })
+	if err != nil {
+		s.logger(ctx).Error("failed to save the acceptance", "auction", a.id, "err", err)
+		return nil, status.Error(codes.Internal, "failed to save the acceptance")
+	}
+	return &pb.AcceptResponse{Accepted: accepted, PromisedBallot: a.paxos.promised.proto(), Timestamp: ts.proto()}, nil
+}
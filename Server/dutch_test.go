@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDutchAcceptanceIsDecidedOnce(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := c.Client(1).CreateAuction(ctx, &pb.CreateAuctionRequest{
+		Auction:           "tulips",
+		Mode:              pb.AuctionMode_DUTCH,
+		Opening:           100,
+		Decrement:         1,
+		DecrementInterval: durationpb.New(time.Hour),
+		Close:             &pb.CreateAuctionRequest_Duration{Duration: durationpb.New(time.Hour)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id := 2; id <= 3; id++ {
+		eventually(t, "the auction reaches every node", func() bool {
+			_, err := c.Client(id).Result(ctx, &pb.ResultRequest{Auction: "tulips"})
+			return err == nil
+		})
+	}
+
+	// Three bidders accept the price at the same time on different nodes.
+	bidders := []string{"Alice", "Bob", "Carol"}
+	responses := make([]*pb.BidResponse, len(bidders))
+	var wg sync.WaitGroup
+	for i, bidder := range bidders {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := c.Client(i+1).Bid(ctx, &pb.BidRequest{Auction: "tulips", Bidder: bidder, Amount: 100})
+			if err != nil {
+				t.Errorf("Bid(%s) on node %d: %v", bidder, i+1, err)
+				return
+			}
+			responses[i] = resp
+		}()
+	}
+	wg.Wait()
+
+	winner := ""
+	for i, resp := range responses {
+		if resp != nil && resp.Message == "success" {
+			if winner != "" {
+				t.Fatalf("both %s and %s won", winner, bidders[i])
+			}
+			winner = bidders[i]
+		}
+	}
+	if winner == "" {
+		t.Fatalf("nobody won: %v", responses)
+	}
+
+	want := "Auction over. Winner: " + winner + " with bid 100"
+	for id := 1; id <= 3; id++ {
+		eventually(t, "every node reports the winner", func() bool {
+			resp, err := c.Client(id).Result(ctx, &pb.ResultRequest{Auction: "tulips"})
+			return err == nil && strings.HasPrefix(resp.Highestbid, want)
+		})
+	}
+}
+
+func TestAcceptor(t *testing.T) {
+	var p acceptor
+	alice := acceptance{bidder: "Alice", origin: 1}
+	bob := acceptance{bidder: "Bob", origin: 2}
+
+	if !p.prepare(ballot{round: 1, node: 1}) {
+		t.Fatal("first prepare refused")
+	}
+	if p.prepare(ballot{round: 0, node: 3}) {
+		t.Error("prepare of a lower ballot promised")
+	}
+	if !p.accept(ballot{round: 1, node: 1}, alice) {
+		t.Fatal("accept of the promised ballot refused")
+	}
+	if !p.prepare(ballot{round: 1, node: 2}) {
+		t.Fatal("prepare of a higher ballot refused")
+	}
+	if p.accept(ballot{round: 1, node: 1}, bob) {
+		t.Error("accept of a ballot lower than the promise succeeded")
+	}
+	if p.accepted == nil || p.accepted.bidder != "Alice" || p.acceptedBallot != (ballot{round: 1, node: 1}) {
+		t.Errorf("accepted %v in %v, want Alice in round 1 of node 1", p.accepted, p.acceptedBallot)
+	}
+}
+
+func TestAcceptorSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "paxos.log")
+	clk := clock.NewFake(time.Unix(100, 0))
+	ctx := asPeer(context.Background(), clusterSecret)
+	start := func() *peerServer {
+		s := newAuctionServer(1, clk)
+		s.clusterSecret = clusterSecret
+		if err := s.openAcceptors(path); err != nil {
+			t.Fatal(err)
+		}
+		return &peerServer{AuctionServer: s}
+	}
+
+	s := start()
+	v := &pb.Acceptance{Bidder: "Alice", Origin: 2, Accepted: &pb.HLC{Wall: 1}}
+	if resp, err := s.Accept(ctx, &pb.AcceptRequest{Auction: "tulips", Ballot: &pb.Ballot{Round: 2, Node: 2}, Acceptance: v}); err != nil || !resp.Accepted {
+		t.Fatalf("Accept = %v, %v", resp, err)
+	}
+
+	// Once restarted, the node keeps its promise and reports what it
+	// accepted.
+	s.Stop()
+	s = start()
+	defer s.Stop()
+	if resp, err := s.Prepare(ctx, &pb.PrepareRequest{Auction: "tulips", Ballot: &pb.Ballot{Round: 1, Node: 3}}); err != nil || resp.Promised {
+		t.Errorf("Prepare of a lower ballot after the restart = %v, %v, want it refused", resp, err)
+	}
+	resp, err := s.Prepare(ctx, &pb.PrepareRequest{Auction: "tulips", Ballot: &pb.Ballot{Round: 3, Node: 3}})
+	if err != nil || !resp.Promised {
+		t.Fatalf("Prepare of a higher ballot after the restart = %v, %v", resp, err)
+	}
+	if resp.Accepted.GetBidder() != "Alice" || resp.AcceptedBallot.GetRound() != 2 {
+		t.Errorf("Prepare after the restart reports %v in %v, want Alice's acceptance in round 2", resp.Accepted, resp.AcceptedBallot)
+	}
+}
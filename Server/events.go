@@ -0,0 +1,88 @@
+package main
+
+import (
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind by
+// before it is dropped.
+const subscriberBuffer = 64
+
+// event is something that happened to an auction on this node: a bid
+// accepted or rejected by any node, or a decision of the auctioneer.
+type event struct {
+	// kind is "bid", "close", "cancel" or "retract".
+	kind string
+	// data is the BidRecord of a bid, or the Admin request of a decision.
+	data proto.Message
+}
+
+// subscriber receives the events of one auction.
+type subscriber struct {
+	auction string
+	events  chan event
+}
+
+// subscribe returns a channel of the events of the auction id from now on,
+// and a function to stop receiving them. The channel is closed if the
+// subscriber falls too far behind, or once it is cancelled.
+func (s *AuctionServer) subscribe(id string) (<-chan event, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &subscriber{auction: id, events: make(chan event, subscriberBuffer)}
+	if s.subscribers == nil {
+		s.subscribers = map[*subscriber]bool{}
+	}
+	s.subscribers[sub] = true
+	return sub.events, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.unsubscribe(sub)
+	}
+}
+
+// unsubscribe removes sub and closes its channel, unless it is already
+// gone.
+// s.mu must be held.
+func (s *AuctionServer) unsubscribe(sub *subscriber) {
+	if s.subscribers[sub] {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// publish sends the event to the subscribers of the auction a. It never
+// blocks: a subscriber whose buffer is full is dropped instead, and has to
+// subscribe again.
+// s.mu must be held.
+func (s *AuctionServer) publish(a *auction, kind string, data proto.Message) {
+	for sub := range s.subscribers {
+		if sub.auction != a.id {
+			continue
+		}
+		select {
+		case sub.events <- event{kind: kind, data: data}:
+		default:
+			s.log.Warn("dropping slow event subscriber", "auction", a.id)
+			s.unsubscribe(sub)
+		}
+	}
+}
+
+// publishRecord publishes the record r of the auction a. Until a sealed
+// auction closes, the bidder and amount are left out.
+// s.mu must be held.
+func (s *AuctionServer) publishRecord(a *auction, r record) {
+	if len(s.subscribers) == 0 {
+		return
+	}
+	closed := a.state(s.hlc.now()) == pb.AuctionState_CLOSED
+	rec := a.view(r, closed)
+	if a.sealed() && !closed {
+		rec.Bidder, rec.Amount = "", 0
+	}
+	s.publish(a, "bid", rec)
+}
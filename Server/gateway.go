@@ -0,0 +1,279 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/logging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// openAPI describes the gateway's endpoints.
+//
+//go:embed openapi.yaml
+var openAPI []byte
+
+// page is the live view of an auction.
+//
+//go:embed index.html
+var page []byte
+
+var jsonOptions = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// gateway serves the Auction service as JSON over HTTP, for clients that
+// cannot speak gRPC. It calls the node's own AuctionServer, and messages
+// are encoded with the standard JSON mapping of protocol buffers, using the
+// field names of the .proto file.
+type gateway struct {
+	s *AuctionServer
+}
+
+// handler returns the gateway's routes.
+func (g gateway) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/auctions", g.listAuctions)
+	mux.HandleFunc("GET /api/auctions/{auction}", g.result)
+	mux.HandleFunc("GET /api/auctions/{auction}/bids", g.listBids)
+	mux.HandleFunc("POST /api/auctions/{auction}/bids", g.bid)
+	mux.HandleFunc("GET /api/auctions/{auction}/events", g.events)
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page)
+	})
+	mux.HandleFunc("GET /api/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(openAPI)
+	})
+	return withRequest(mux)
+}
+
+// withRequest gives every request an ID, taken from its X-Request-ID
+// header if it has one, and passes its Authorization header on as the
+// metadata the Admin token is read from.
+func withRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-ID")
+		if id == "" {
+			id = logging.NewRequestID()
+		}
+		w.Header().Set("X-Request-ID", id)
+		ctx := logging.WithRequestID(r.Context(), id)
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", auth))
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func auctionID(r *http.Request) string {
+	if id := r.PathValue("auction"); id != defaultAuctionName {
+		return id
+	}
+	return defaultAuction
+}
+
+func (g gateway) listAuctions(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.s.ListAuctions(r.Context(), &pb.ListAuctionsRequest{})
+	g.reply(w, r, http.StatusOK, resp, err)
+}
+
+func (g gateway) result(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.s.Result(r.Context(), &pb.ResultRequest{Auction: auctionID(r)})
+	g.reply(w, r, http.StatusOK, resp, err)
+}
+
+// bid places the bid in the request body. An accepted bid is answered with
+// 200 OK and a rejected one with 409 Conflict, both with the BidResponse.
+func (g gateway) bid(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 1<<20))
+	if err != nil {
+		g.reply(w, r, 0, nil, status.Errorf(codes.InvalidArgument, "failed to read request: %v", err))
+		return
+	}
+	req := &pb.BidRequest{}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		g.reply(w, r, 0, nil, status.Errorf(codes.InvalidArgument, "invalid bid: %v", err))
+		return
+	}
+	req.Auction, req.Timestamp = auctionID(r), nil
+	resp, err := g.s.Bid(r.Context(), req)
+	code := http.StatusOK
+	if resp.GetMessage() != "success" {
+		code = http.StatusConflict
+	}
+	g.reply(w, r, code, resp, err)
+}
+
+// listBids lists a page of bids, with the filters of ListBidsRequest as
+// query parameters.
+func (g gateway) listBids(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &pb.ListBidsRequest{Auction: auctionID(r), Bidder: q.Get("bidder"), PageToken: q.Get("page_token")}
+	var err error
+	if req.From, err = queryTime(q.Get("from")); err != nil {
+		g.reply(w, r, 0, nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err))
+		return
+	}
+	if req.To, err = queryTime(q.Get("to")); err != nil {
+		g.reply(w, r, 0, nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err))
+		return
+	}
+	if v := q.Get("outcome"); v != "" {
+		outcome, ok := pb.BidOutcome_value[v]
+		if !ok {
+			g.reply(w, r, 0, nil, status.Errorf(codes.InvalidArgument, "invalid outcome %q", v))
+			return
+		}
+		req.Outcome = pb.BidOutcome(outcome).Enum()
+	}
+	if v := q.Get("page_size"); v != "" {
+		size, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			g.reply(w, r, 0, nil, status.Errorf(codes.InvalidArgument, "invalid page_size %q", v))
+			return
+		}
+		req.PageSize = int32(size)
+	}
+	resp, err := g.s.ListBids(r.Context(), req)
+	g.reply(w, r, http.StatusOK, resp, err)
+}
+
+// events streams the events of an auction as Server-Sent Events, from
+// when the request is made until the client goes away. Each has the kind
+// of the event as its type and the JSON of its message as its data.
+func (g gateway) events(w http.ResponseWriter, r *http.Request) {
+	id := auctionID(r)
+	g.s.mu.Lock()
+	_, err := g.s.lookup(id)
+	g.s.mu.Unlock()
+	if err != nil {
+		g.reply(w, r, 0, nil, err)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		g.reply(w, r, 0, nil, status.Error(codes.Unimplemented, "streaming is not supported"))
+		return
+	}
+
+	events, cancel := g.s.subscribe(id)
+	defer cancel()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	// Ask browsers to reconnect soon if the stream ends, for example
+	// because they fell behind.
+	fmt.Fprint(w, "retry: 1000\n\n")
+	flusher.Flush()
+
+	keepalive := time.NewTicker(15 * time.Second)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-g.s.done:
+			return
+		case <-keepalive.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := jsonOptions.Marshal(ev.data)
+			if err != nil {
+				g.s.logger(r.Context()).Error("failed to encode event", "kind", ev.kind, "err", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.kind, data)
+		}
+		flusher.Flush()
+	}
+}
+
+func queryTime(v string) (*timestamppb.Timestamp, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
+
+// reply writes resp as JSON with the status code, or, if err is set, an
+// error object with the HTTP status code of its gRPC code.
+func (g gateway) reply(w http.ResponseWriter, r *http.Request, code int, resp proto.Message, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		st := status.Convert(err)
+		code := httpStatus(st.Code())
+		if code >= 500 {
+			g.s.logger(r.Context()).Error("gateway request failed", "method", r.Method, "path", r.URL.Path, "err", err)
+		}
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(map[string]string{"code": st.Code().String(), "message": st.Message()})
+		return
+	}
+	body, err := jsonOptions.Marshal(resp)
+	if err != nil {
+		g.s.logger(r.Context()).Error("failed to encode response", "path", r.URL.Path, "err", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+// httpStatus returns the HTTP status code for a gRPC status code.
+func httpStatus(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// serveGateway serves the gateway of s on addr until the returned server is
+// shut down.
+func serveGateway(addr string, s *AuctionServer) *http.Server {
+	srv := &http.Server{Addr: addr, Handler: gateway{s}.handler()}
+	s.log.Info("gateway listening", "addr", addr)
+	go func() {
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			s.log.Error("failed to serve gateway", "err", err)
+			os.Exit(1)
+		}
+	}()
+	return srv
+}
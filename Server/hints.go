@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// hintStore holds replication messages that could not be delivered to a
+// peer. Hints are kept sorted by (epoch, seq) and mirrored to an append-only
+// file so they survive a restart of this node.
+type hintStore struct {
+	mu    sync.Mutex
+	path  string
+	file  *os.File
+	hints []*pb.ReplicateRequest
+}
+
+// openHintStore loads any hints left in path by a previous run and opens the
+// file for appending.
+func openHintStore(path string) (*hintStore, error) {
+	h := &hintStore{path: path}
+
+	f, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		r := bufio.NewReader(f)
+		for {
+			msg := &pb.ReplicateRequest{}
+			// Stop at EOF or at a torn write left by a crash; the latter
+			// only loses the hint that was being written at the time.
+			if err := protodelim.UnmarshalFrom(r, msg); err != nil {
+				break
+			}
+			h.insert(msg)
+		}
+		f.Close()
+	}
+
+	h.file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// add stores msg durably.
+func (h *hintStore) add(msg *pb.ReplicateRequest) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, err := protodelim.MarshalTo(h.file, msg); err != nil {
+		return err
+	}
+	if err := h.file.Sync(); err != nil {
+		return err
+	}
+	h.insert(msg)
+	return nil
+}
+
+// insert places msg in (epoch, seq) order, ignoring duplicates.
+func (h *hintStore) insert(msg *pb.ReplicateRequest) {
+	i := sort.Search(len(h.hints), func(i int) bool {
+		return !before(h.hints[i], msg)
+	})
+	if i < len(h.hints) && !before(msg, h.hints[i]) {
+		return
+	}
+	h.hints = append(h.hints, nil)
+	copy(h.hints[i+1:], h.hints[i:])
+	h.hints[i] = msg
+}
+
+// peek returns the oldest hint, or nil when there are none.
+func (h *hintStore) peek() *pb.ReplicateRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.hints) == 0 {
+		return nil
+	}
+	return h.hints[0]
+}
+
+// pop removes the oldest hint once it has been delivered. The file is
+// truncated when the last hint is gone.
+func (h *hintStore) pop() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.hints) == 0 {
+		return nil
+	}
+	h.hints = h.hints[1:]
+	if len(h.hints) == 0 {
+		return h.file.Truncate(0)
+	}
+	return nil
+}
+
+func (h *hintStore) len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.hints)
+}
+
+func (h *hintStore) close() error {
+	return h.file.Close()
+}
+
+// before reports whether a was sent before b by its origin.
+func before(a, b *pb.ReplicateRequest) bool {
+	if a.Epoch != b.Epoch {
+		return a.Epoch < b.Epoch
+	}
+	return a.Seq < b.Seq
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+)
+
+func TestHintStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hints-1-2.log")
+	h, err := openHintStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := func(epoch, seq int64) *pb.ReplicateRequest {
+		return &pb.ReplicateRequest{Origin: 1, Epoch: epoch, Seq: seq}
+	}
+	// Out of order and with a duplicate, as when a message that failed is
+	// hinted after later ones.
+	for _, m := range []*pb.ReplicateRequest{msg(1, 2), msg(1, 1), msg(2, 1), msg(1, 2)} {
+		if err := h.add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := h.len(); n != 3 {
+		t.Fatalf("len() = %d, want 3", n)
+	}
+	if err := h.pop(); err != nil {
+		t.Fatal(err)
+	}
+	h.close()
+
+	// The file still has every hint, including the delivered one, which
+	// the peer ignores as a duplicate.
+	h, err = openHintStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][2]int64
+	for m := h.peek(); m != nil; m = h.peek() {
+		got = append(got, [2]int64{m.Epoch, m.Seq})
+		if err := h.pop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h.close()
+	if want := [][2]int64{{1, 1}, {1, 2}, {2, 1}}; !slices.Equal(got, want) {
+		t.Errorf("reloaded hints %v, want %v", got, want)
+	}
+
+	if info, err := os.Stat(path); err != nil || info.Size() != 0 {
+		t.Errorf("hint file after the last pop: %v, %v; want it empty", info.Size(), err)
+	}
+}
+
+func TestHintStoreTornWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hints-1-2.log")
+	h, err := openHintStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.add(&pb.ReplicateRequest{Origin: 1, Epoch: 1, Seq: 1, RequestId: "first"})
+	h.close()
+
+	// A crash in the middle of writing the second hint leaves part of it.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{40, 8, 1})
+	f.Close()
+
+	h, err = openHintStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.close()
+	if h.len() != 1 || h.peek().RequestId != "first" {
+		t.Errorf("after a torn write, hints are %d starting with %v; want only the first", h.len(), h.peek())
+	}
+}
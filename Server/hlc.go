@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+)
+
+// timestamp is a hybrid logical clock reading: the wall clock in Unix
+// nanoseconds, and a counter that orders events sharing the same wall time.
+type timestamp struct {
+	wall    int64
+	logical int32
+}
+
+func (t timestamp) before(o timestamp) bool {
+	if t.wall != o.wall {
+		return t.wall < o.wall
+	}
+	return t.logical < o.logical
+}
+
+func (t timestamp) time() time.Time {
+	return time.Unix(0, t.wall)
+}
+
+func (t timestamp) String() string {
+	return fmt.Sprintf("%s.%d", t.time().Format("15:04:05.000000"), t.logical)
+}
+
+// LogValue logs t as its String.
+func (t timestamp) LogValue() slog.Value {
+	return slog.StringValue(t.String())
+}
+
+func (t timestamp) proto() *pb.HLC {
+	return &pb.HLC{Wall: t.wall, Logical: t.logical}
+}
+
+func fromProto(h *pb.HLC) timestamp {
+	return timestamp{wall: h.GetWall(), logical: h.GetLogical()}
+}
+
+// defaultMaxOffset is how far apart the nodes' wall clocks are assumed to
+// be at most.
+const defaultMaxOffset = 500 * time.Millisecond
+
+// hlc is a hybrid logical clock. Its readings stay close to physical time
+// but never go backwards and always follow any timestamp it has seen, so
+// an event that causally depends on another gets a later timestamp even
+// when the nodes' wall clocks disagree.
+type hlc struct {
+	mu    sync.Mutex
+	last  timestamp
+	clock clock.Clock
+	// maxOffset is how far ahead of the physical clock a timestamp from
+	// another node may be. A timestamp further ahead comes from a broken
+	// clock or a forged message, and following it would move this clock,
+	// and every clock that hears from it, as far ahead, closing auctions
+	// early.
+	maxOffset time.Duration
+}
+
+func newHLC(clk clock.Clock) *hlc {
+	return &hlc{clock: clk, maxOffset: defaultMaxOffset}
+}
+
+// tick returns the timestamp of a local or send event.
+func (c *hlc) tick() timestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pt := c.clock.Now().UnixNano()
+	if pt > c.last.wall {
+		c.last = timestamp{wall: pt}
+	} else {
+		c.last.logical++
+	}
+	return c.last
+}
+
+// now returns the current reading of the clock without recording an
+// event. It is no earlier than any timestamp returned so far, and the next
+// tick may return it as well.
+func (c *hlc) now() timestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if pt := c.clock.Now().UnixNano(); pt > c.last.wall {
+		return timestamp{wall: pt}
+	}
+	return c.last
+}
+
+// update merges a timestamp received in a message from another node and
+// returns the timestamp of the receive event. It refuses a timestamp more
+// than the maximum offset ahead of the physical clock, leaving the clock as
+// it was. Timestamps from clients are never merged: clients are not
+// trusted to keep time.
+func (c *hlc) update(remote timestamp) (timestamp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pt := c.clock.Now().UnixNano()
+	if ahead := time.Duration(remote.wall - pt); ahead > c.maxOffset {
+		return timestamp{}, fmt.Errorf("timestamp %v is %v ahead of the clock, more than the maximum offset of %v", remote, ahead, c.maxOffset)
+	}
+	switch wall := max(c.last.wall, remote.wall, pt); {
+	case wall == c.last.wall && wall == remote.wall:
+		c.last.logical = max(c.last.logical, remote.logical) + 1
+	case wall == c.last.wall:
+		c.last.logical++
+	case wall == remote.wall:
+		c.last = timestamp{wall: wall, logical: remote.logical + 1}
+	default:
+		c.last = timestamp{wall: wall}
+	}
+	return c.last, nil
+}
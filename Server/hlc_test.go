@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHLCTick(t *testing.T) {
+	clk := clock.NewFake(time.Unix(100, 0))
+	c := newHLC(clk)
+
+	first := c.tick()
+	if want := (timestamp{wall: clk.Now().UnixNano()}); first != want {
+		t.Fatalf("first tick = %v, want %v", first, want)
+	}
+	// Without the clock moving, the logical counter orders events.
+	if second := c.tick(); second != (timestamp{wall: first.wall, logical: 1}) {
+		t.Errorf("second tick = %v, want logical 1", second)
+	}
+	// Reading the clock does not move it.
+	if now := c.now(); now != (timestamp{wall: first.wall, logical: 1}) {
+		t.Errorf("now() after the second tick = %v, want it", now)
+	}
+	clk.Advance(time.Millisecond)
+	if third := c.tick(); third != (timestamp{wall: clk.Now().UnixNano()}) {
+		t.Errorf("tick after the clock moved = %v, want the new wall time", third)
+	}
+}
+
+func TestHLCUpdate(t *testing.T) {
+	clk := clock.NewFake(time.Unix(100, 0))
+	c := newHLC(clk)
+	now := clk.Now().UnixNano()
+
+	tests := []struct {
+		name   string
+		remote timestamp
+		want   timestamp
+	}{
+		{"remote behind", timestamp{wall: now - 10}, timestamp{wall: now}},
+		{"remote ahead", timestamp{wall: now + 10, logical: 3}, timestamp{wall: now + 10, logical: 4}},
+		{"remote equal", timestamp{wall: now + 10, logical: 7}, timestamp{wall: now + 10, logical: 8}},
+		{"remote equal, lower logical", timestamp{wall: now + 10, logical: 2}, timestamp{wall: now + 10, logical: 9}},
+	}
+	for _, tt := range tests {
+		if got, err := c.update(tt.remote); err != nil || got != tt.want {
+			t.Errorf("%s: update(%v) = %v, %v, want %v", tt.name, tt.remote, got, err, tt.want)
+		}
+	}
+}
+
+func TestHLCNeverGoesBack(t *testing.T) {
+	clk := clock.NewFake(time.Unix(100, 0))
+	c := newHLC(clk)
+
+	last := c.tick()
+	for i := 0; i < 100; i++ {
+		var next timestamp
+		if i%3 == 0 {
+			next, _ = c.update(timestamp{wall: last.wall - int64(i)})
+		} else {
+			next = c.tick()
+		}
+		if !last.before(next) {
+			t.Fatalf("%v does not come after %v", next, last)
+		}
+		last = next
+		if i%10 == 0 {
+			clk.Advance(time.Nanosecond)
+		}
+	}
+}
+
+func TestHLCMaxOffset(t *testing.T) {
+	clk := clock.NewFake(time.Unix(100, 0))
+	c := newHLC(clk)
+	now := clk.Now().UnixNano()
+
+	// A timestamp up to the maximum offset ahead is followed.
+	within := timestamp{wall: now + int64(defaultMaxOffset)}
+	if got, err := c.update(within); err != nil || got != (timestamp{wall: within.wall, logical: 1}) {
+		t.Errorf("update(%v) = %v, %v, want it followed", within, got, err)
+	}
+
+	// One further ahead is refused, and leaves the clock where it was.
+	ahead := timestamp{wall: now + int64(48*time.Hour)}
+	if _, err := c.update(ahead); err == nil {
+		t.Errorf("update(%v) 48h ahead succeeded", ahead)
+	}
+	if got := c.tick(); got != (timestamp{wall: within.wall, logical: 2}) {
+		t.Errorf("tick after a refused update = %v, want it to follow %v", got, within)
+	}
+}
+
+func TestRemoteTimestamps(t *testing.T) {
+	clk := clock.NewFake(time.Unix(100, 0))
+	s := newAuctionServer(1, clk)
+	ctx := context.Background()
+	future := (timestamp{wall: clk.Now().Add(48 * time.Hour).UnixNano()}).proto()
+
+	// A client's timestamp is not merged, so a bid dated two days ahead
+	// neither closes the auction nor is refused.
+	if resp, err := s.Bid(ctx, &pb.BidRequest{Bidder: "Alice", Amount: 5, Timestamp: future}); err != nil || resp.Message != "success" {
+		t.Fatalf("Bid with a timestamp 48h ahead = %v, %v, want success", resp, err)
+	}
+	if resp, err := s.Result(ctx, &pb.ResultRequest{}); err != nil || resp.State != pb.AuctionState_OPEN {
+		t.Errorf("Result after the bid = %v, %v, want the auction still open", resp, err)
+	}
+
+	// A node's timestamp that far ahead is refused.
+	s.clusterSecret = clusterSecret
+	s.nodes = append(s.nodes, s.newNode(2, "node2"))
+	msg := &pb.ReplicateRequest{Origin: 2, Epoch: 1, Seq: 1, Timestamp: future,
+		Op: &pb.ReplicateRequest_Bid{Bid: &pb.BidRequest{Bidder: "Bob", Amount: 6, Timestamp: future}}}
+	if _, err := (&peerServer{AuctionServer: s}).Replicate(asPeer(ctx, clusterSecret), msg); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Replicate with a timestamp 48h ahead: %v, want FailedPrecondition", err)
+	}
+	if resp, err := s.Result(ctx, &pb.ResultRequest{}); err != nil || resp.State != pb.AuctionState_OPEN || resp.Highestbid != "5" {
+		t.Errorf("Result after the refused Replicate = %v, %v, want the auction open at 5", resp, err)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// nextIncarnation returns the number of this run of a node, one more than
+// the last one recorded in path, and records it there before returning.
+// The number is the epoch of the node's replication messages. Peers ignore
+// messages from an epoch older than the newest they have seen, so it must
+// grow with every run, which a clock cannot promise: a fake clock reads the
+// same time after every restart, and a real one may be stepped back.
+func nextIncarnation(path string) (int64, error) {
+	var last int64
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if last, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err != nil {
+			return 0, fmt.Errorf("invalid incarnation in %s: %w", path, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return 0, err
+	}
+
+	// Replace the file in one step, so that a crash leaves either the old
+	// number or the new one.
+	next := last + 1
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := fmt.Fprintln(f, next); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, err
+	}
+	return next, syncDir(filepath.Dir(path))
+}
+
+// syncDir makes the creation or renaming of files in dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
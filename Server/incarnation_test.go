@@ -0,0 +1,27 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNextIncarnation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "incarnation")
+	for want := int64(1); want <= 3; want++ {
+		got, err := nextIncarnation(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("nextIncarnation = %d, want %d", got, want)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte("junk\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nextIncarnation(path); err == nil {
+		t.Error("nextIncarnation of a junk file succeeded")
+	}
+}
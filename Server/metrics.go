@@ -0,0 +1,93 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	bidsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auction_bids_total",
+		Help: "Bids and maximums this node received, by auction and outcome.",
+	}, []string{"auction", "outcome"})
+	resultsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auction_result_requests_total",
+		Help: "Result calls this node answered, by auction.",
+	}, []string{"auction"})
+	highestBid = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auction_highest_bid",
+		Help: "The leading bid of each auction that is not sealed, as this node knows it.",
+	}, []string{"auction"})
+	replicationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "auction_replication_duration_seconds",
+		Help:    "How long Replicate calls to each peer took, including failed ones.",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"peer"})
+	replicationFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auction_replication_failures_total",
+		Help: "Replicate calls to each peer that failed.",
+	}, []string{"peer"})
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auction_replication_queue_depth",
+		Help: "Messages waiting to be sent to each peer.",
+	}, []string{"peer"})
+	replicatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auction_replication_sent_total",
+		Help: "Messages delivered to each peer.",
+	}, []string{"peer"})
+	hintedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auction_replication_hinted_total",
+		Help: "Messages stored as hints for each peer.",
+	}, []string{"peer"})
+	hintsPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auction_replication_hints_pending",
+		Help: "Hints not yet handed off to each peer.",
+	}, []string{"peer"})
+	peerUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "auction_peer_up",
+		Help: "Whether each peer answered its last health check.",
+	}, []string{"peer"})
+)
+
+// label returns the auction's ID for use as a metric label.
+func (a *auction) label() string {
+	if a.id == defaultAuction {
+		return defaultAuctionName
+	}
+	return a.id
+}
+
+// countBid counts a bid in the auction a with outcome "accepted" or
+// "rejected".
+func countBid(a *auction, outcome string) {
+	bidsTotal.WithLabelValues(a.label(), outcome).Inc()
+}
+
+// observeHighest updates the highest bid of the auction a, unless bids are
+// sealed.
+func observeHighest(a *auction) {
+	if !a.sealed() {
+		highestBid.WithLabelValues(a.label()).Set(float64(a.leading().amount))
+	}
+}
+
+// serveMetrics serves the metrics at /metrics on addr until the returned
+// server is shut down.
+func serveMetrics(addr string, log *slog.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: addr, Handler: mux}
+	log.Info("metrics listening", "addr", addr)
+	go func() {
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			log.Error("failed to serve metrics", "err", err)
+			os.Exit(1)
+		}
+	}()
+	return srv
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/logging"
+	"MandatoryActivity5/tracing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// replicationQueueSize bounds how many messages may wait for a single peer.
+	replicationQueueSize = 128
+
+	minRetryBackoff = 100 * time.Millisecond
+	maxRetryBackoff = 30 * time.Second
+)
+
+// connect opens the long-lived connection to the node and starts the
+// worker that delivers its messages in order. The node must be open.
+func (n *Node) connect(opts ...grpc.DialOption) error {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(n.addr, opts...)
+	if err != nil {
+		return err
+	}
+	n.conn = conn
+	n.client = pb.NewPeerClient(conn)
+	n.health = healthpb.NewHealthClient(conn)
+	n.stopped = make(chan struct{})
+	go n.run()
+	return nil
+}
+
+// open loads the hints left in hintPath and the delivery mark left in
+// markPath, and prepares the node's queue.
+func (n *Node) open(hintPath, markPath string) error {
+	hints, err := openHintStore(hintPath)
+	if err != nil {
+		return err
+	}
+	delivered, err := openDeliveryMark(markPath)
+	if err != nil {
+		hints.close()
+		return err
+	}
+	n.delivered = delivered
+	n.queue = make(chan *pb.ReplicateRequest, replicationQueueSize)
+	n.wake = make(chan struct{}, 1)
+	n.flushes = make(chan chan struct{})
+	n.hints = hints
+	n.backoff = minRetryBackoff
+	if hints.len() > 0 {
+		n.retryAt = n.clock.After(0)
+	}
+	hintsPending.WithLabelValues(n.name()).Set(float64(hints.len()))
+	return nil
+}
+
+// enqueue appends msg to the node's replication queue. It is called with
+// s.mu held, so it never waits: when the queue is full, msg is stored as a
+// hint instead, and the worker is asked to hand off the hints, which also
+// takes in whatever is queued, so that everything still arrives in order.
+func (n *Node) enqueue(msg *pb.ReplicateRequest) {
+	select {
+	case n.queue <- msg:
+		queueDepth.WithLabelValues(n.name()).Set(float64(len(n.queue)))
+	default:
+		// The delivery mark is left to the worker, which has not handed
+		// over what is queued yet.
+		n.log.Warn("replication queue is full, storing the message as a hint", "seq", msg.Seq, "request_id", msg.RequestId)
+		n.hint(msg)
+		n.retry()
+	}
+}
+
+// retry asks the worker to redeliver stored hints right away.
+func (n *Node) retry() {
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers queued messages one at a time so they arrive in the order
+// they were sent. Once a delivery fails, that message and everything after
+// it goes to the hint store until the hints have been handed off, which is
+// retried with exponential backoff while the node is up. When this node
+// stops, whatever is still queued is left to be sent again from the state
+// log, as after a crash.
+func (n *Node) run() {
+	defer close(n.stopped)
+	for {
+		select {
+		case <-n.done:
+			n.close()
+			return
+		case msg := <-n.queue:
+			n.deliver(msg)
+		case <-n.wake:
+			n.wakeUp()
+		case <-n.retryAt:
+			n.redeliver()
+		case flushed := <-n.flushes:
+			n.flushQueue()
+			close(flushed)
+		}
+	}
+}
+
+// flush waits until everything queued for the node so far has been
+// delivered or stored as a hint, or until ctx is done.
+func (n *Node) flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	select {
+	case n.flushes <- flushed:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flushQueue delivers the queued messages, and the hints too if the node is
+// up, keeping as hints whatever it cannot deliver.
+func (n *Node) flushQueue() {
+	for len(n.queue) > 0 {
+		n.deliver(<-n.queue)
+	}
+	if n.hints.len() > 0 && n.active.Load() {
+		n.handoff()
+	}
+}
+
+// pending reports whether the node has work that step would do.
+func (n *Node) pending() bool {
+	return len(n.wake) > 0 || len(n.queue) > 0 || (n.retryAt != nil && len(n.retryAt) > 0)
+}
+
+// step does one piece of the work run would do, without blocking, and
+// reports whether there was any. It checks for work in a fixed order, so a
+// caller driving nodes one step at a time gets the same result every time.
+func (n *Node) step() bool {
+	select {
+	case <-n.wake:
+		n.wakeUp()
+		return true
+	default:
+	}
+	select {
+	case <-n.retryAt:
+		n.redeliver()
+		return true
+	default:
+	}
+	select {
+	case msg := <-n.queue:
+		n.deliver(msg)
+		return true
+	default:
+	}
+	return false
+}
+
+// deliver sends msg, or stores it as a hint, and moves the delivery mark
+// to it. Once this node is stopping, it leaves msg to the state log.
+func (n *Node) deliver(msg *pb.ReplicateRequest) {
+	queueDepth.WithLabelValues(n.name()).Set(float64(len(n.queue)))
+	if n.stopping() {
+		return
+	}
+	if n.hints.len() > 0 || !n.active.Load() {
+		n.hintInOrder(msg)
+		return
+	}
+	if err := n.send(msg); err != nil {
+		if n.stopping() {
+			// The call failed because the connection was closed under it.
+			return
+		}
+		n.log.Warn("failed to replicate", "seq", msg.Seq, "request_id", msg.RequestId, "err", err)
+		n.hintInOrder(msg)
+		if n.retryAt == nil {
+			n.retryAt = n.clock.After(n.backoff)
+		}
+		return
+	}
+	n.advance(msg)
+}
+
+// stopping reports whether this node is stopping.
+func (n *Node) stopping() bool {
+	select {
+	case <-n.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (n *Node) wakeUp() {
+	n.backoff = minRetryBackoff
+	n.retryAt = n.clock.After(0)
+}
+
+func (n *Node) redeliver() {
+	n.retryAt = nil
+	if n.handoff() {
+		n.backoff = minRetryBackoff
+		return
+	}
+	if n.active.Load() {
+		n.backoff = min(2*n.backoff, maxRetryBackoff)
+		n.retryAt = n.clock.After(n.backoff)
+	}
+}
+
+// handoff delivers stored hints oldest first and reports whether all of
+// them were delivered.
+func (n *Node) handoff() bool {
+	// Anything still queued was sent after some of the hints; move it to the
+	// store so it is delivered in order.
+	for len(n.queue) > 0 {
+		n.hintInOrder(<-n.queue)
+	}
+	queueDepth.WithLabelValues(n.name()).Set(0)
+
+	delivered := 0
+	defer func() {
+		if delivered > 0 {
+			n.log.Info("handed off hints", "delivered", delivered, "left", n.hints.len())
+		}
+		hintsPending.WithLabelValues(n.name()).Set(float64(n.hints.len()))
+	}()
+
+	for msg := n.hints.peek(); msg != nil; msg = n.hints.peek() {
+		if err := n.send(msg); err != nil {
+			n.log.Warn("failed to hand off hints", "err", err)
+			return false
+		}
+		if err := n.hints.pop(); err != nil {
+			n.log.Error("failed to remove delivered hint", "err", err)
+		}
+		delivered++
+	}
+	return true
+}
+
+// hint stores msg as a hint and reports whether it did.
+func (n *Node) hint(msg *pb.ReplicateRequest) bool {
+	if err := n.hints.add(msg); err != nil {
+		n.log.Error("failed to store hint, message is lost", "seq", msg.Seq, "request_id", msg.RequestId, "err", err)
+		return false
+	}
+	hintedTotal.WithLabelValues(n.name()).Inc()
+	hintsPending.WithLabelValues(n.name()).Set(float64(n.hints.len()))
+	return true
+}
+
+// hintInOrder stores msg, which comes right after every message handed
+// over so far, as a hint, and moves the delivery mark to it.
+func (n *Node) hintInOrder(msg *pb.ReplicateRequest) {
+	if n.hint(msg) {
+		n.advance(msg)
+	}
+}
+
+// advance moves the delivery mark to msg.
+func (n *Node) advance(msg *pb.ReplicateRequest) {
+	if err := n.delivered.advance(msg); err != nil {
+		n.log.Warn("failed to record delivery, the message is sent again after a restart", "seq", msg.Seq, "err", err)
+	}
+}
+
+// resend stores as hints the messages of sent, which this node replicated
+// in earlier runs, that are after the delivery mark: they were still
+// queued when it stopped. It must be called before the worker starts, so
+// that the hints go out in order.
+func (n *Node) resend(sent []*pb.ReplicateRequest) {
+	resent := 0
+	for _, msg := range sent {
+		if !n.delivered.covers(msg) {
+			n.hintInOrder(msg)
+			resent++
+		}
+	}
+	if resent > 0 {
+		n.log.Info("sending messages from the state log again", "messages", resent)
+		n.retryAt = n.clock.After(0)
+	}
+}
+
+// close closes the node's hint store and delivery mark.
+func (n *Node) close() {
+	n.hints.close()
+	n.delivered.close()
+}
+
+// merge merges the timestamp of a response from the node into the clock.
+// A timestamp too far ahead is ignored: the call itself succeeded.
+func (n *Node) merge(ts *pb.HLC) {
+	if _, err := n.hlc.update(fromProto(ts)); err != nil {
+		n.log.Warn("ignoring the timestamp of a response", "err", err)
+	}
+}
+
+func (n *Node) send(msg *pb.ReplicateRequest) error {
+	ctx := logging.WithRequestID(tracing.Extract(context.Background(), msg.TraceContext), msg.RequestId)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	start := n.clock.Now()
+	resp, err := n.client.Replicate(ctx, msg)
+	replicationSeconds.WithLabelValues(n.name()).Observe(n.clock.Now().Sub(start).Seconds())
+	if err != nil {
+		replicationFailures.WithLabelValues(n.name()).Inc()
+		return err
+	}
+	n.merge(resp.Timestamp)
+	replicatedTotal.WithLabelValues(n.name()).Inc()
+	return nil
+}
+
+// leave tells the node that this one, self, is shutting down, unless it is
+// down already.
+func (n *Node) leave(ctx context.Context, self int) error {
+	if !n.active.Load() {
+		return nil
+	}
+	resp, err := n.client.Leave(ctx, &pb.LeaveRequest{Node: int32(self), Timestamp: n.hlc.tick().proto()})
+	if err != nil {
+		return err
+	}
+	n.merge(resp.Timestamp)
+	return nil
+}
+
+// ping reports whether the node answers a health check within timeout.
+func (n *Node) ping(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := n.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	return err == nil && resp.Status == healthpb.HealthCheckResponse_SERVING
+}
+
+func (n *Node) name() string {
+	return n.addr
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+)
+
+func TestEnqueueNeverWaits(t *testing.T) {
+	// Nobody advances the clock and no worker drains the queue, so any
+	// wait would last forever.
+	clk := clock.NewFake(time.Unix(100, 0))
+	s := newAuctionServer(1, clk)
+	node := s.newNode(2, "node2")
+	dir := t.TempDir()
+	if err := node.open(filepath.Join(dir, "hints-1-2.log"), filepath.Join(dir, "delivered-1-2")); err != nil {
+		t.Fatal(err)
+	}
+	defer node.close()
+
+	done := make(chan struct{})
+	go func() {
+		for seq := int64(1); seq <= replicationQueueSize+10; seq++ {
+			node.enqueue(&pb.ReplicateRequest{Origin: 1, Epoch: 1, Seq: seq})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("enqueue blocked on a full queue")
+	}
+
+	if len(node.queue) != replicationQueueSize || node.hints.len() != 10 {
+		t.Errorf("%d queued and %d hinted, want %d and 10", len(node.queue), node.hints.len(), replicationQueueSize)
+	}
+	if !node.pending() {
+		t.Error("the worker was not asked to hand off the hints")
+	}
+
+	// The worker takes the queue into the hints before handing them off,
+	// so they go out in the order they were sent.
+	for len(node.queue) > 0 {
+		node.hint(<-node.queue)
+	}
+	for seq := int64(1); node.hints.len() > 0; seq++ {
+		if got := node.hints.peek().Seq; got != seq {
+			t.Fatalf("hint %d has seq %d", seq, got)
+		}
+		node.hints.pop()
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// peerServer is the Peer service of a node, which only the other nodes of
+// the cluster may call: its calls change auctions without the checks
+// clients go through.
+type peerServer struct {
+	pb.UnimplementedPeerServer
+	*AuctionServer
+}
+
+// authenticatePeer checks that ctx carries the cluster secret. Without one
+// the Peer service is disabled.
+func (s *AuctionServer) authenticatePeer(ctx context.Context) error {
+	if s.clusterSecret == "" {
+		return status.Error(codes.PermissionDenied, "peer service is disabled on this node")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		secret, ok := strings.CutPrefix(v, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(secret), []byte(s.clusterSecret)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "the cluster secret is required")
+}
+
+// isPeer reports whether id is another node of the cluster.
+// s.mu must be held.
+func (s *AuctionServer) isPeer(id int32) bool {
+	for _, node := range s.nodes {
+		if int32(node.nodeID) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterCredentials sends the cluster secret with every call to a peer.
+type clusterCredentials string
+
+func (c clusterCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(c)}, nil
+}
+
+// RequireTransportSecurity allows the secret over plaintext connections,
+// which is all the nodes use.
+func (clusterCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// clusterSecret is the cluster secret of the nodes in tests.
+const clusterSecret = "test"
+
+// asPeer returns ctx as it arrives at a node in a call from a peer that
+// sends secret.
+func asPeer(ctx context.Context, secret string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+secret))
+}
+
+func TestPeerAuthentication(t *testing.T) {
+	s := newAuctionServer(1, clock.NewFake(time.Unix(100, 0)))
+	s.nodes = append(s.nodes, s.newNode(2, "node2"))
+	peer := &peerServer{AuctionServer: s}
+	ctx := context.Background()
+	forged := func(origin int32) *pb.ReplicateRequest {
+		return &pb.ReplicateRequest{Origin: origin, Epoch: 1, Seq: 1, Op: &pb.ReplicateRequest_Cancel{Cancel: &pb.CancelAuctionRequest{Reason: "forged"}}}
+	}
+
+	if _, err := peer.Replicate(asPeer(ctx, ""), forged(2)); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Replicate without a cluster secret configured: %v, want PermissionDenied", err)
+	}
+
+	s.clusterSecret = clusterSecret
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *pb.ReplicateRequest
+		want codes.Code
+	}{
+		{"no secret", ctx, forged(2), codes.Unauthenticated},
+		{"wrong secret", asPeer(ctx, "guess"), forged(2), codes.Unauthenticated},
+		{"unknown origin", asPeer(ctx, clusterSecret), forged(7), codes.PermissionDenied},
+		{"own origin", asPeer(ctx, clusterSecret), forged(1), codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		if _, err := peer.Replicate(tt.ctx, tt.req); status.Code(err) != tt.want {
+			t.Errorf("%s: Replicate = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+	if resp, err := s.Result(ctx, &pb.ResultRequest{}); err != nil || resp.State != pb.AuctionState_OPEN {
+		t.Fatalf("Result after refused cancellations = %v, %v, want the auction open", resp, err)
+	}
+
+	if _, err := peer.Replicate(asPeer(ctx, clusterSecret), forged(2)); err != nil {
+		t.Fatalf("Replicate from a peer: %v", err)
+	}
+	if resp, err := s.Result(ctx, &pb.ResultRequest{}); err != nil || resp.State != pb.AuctionState_CANCELLED {
+		t.Errorf("Result after a peer's cancellation = %v, %v, want the auction cancelled", resp, err)
+	}
+}
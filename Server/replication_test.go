@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// placeBid bids amount for bidder through c, failing the test t if the
+// call fails.
+func placeBid(t *testing.T, c pb.AuctionClient, bidder string, amount int32) *pb.BidResponse {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := c.Bid(ctx, &pb.BidRequest{Bidder: bidder, Amount: amount})
+	if err != nil {
+		t.Fatalf("Bid(%s, %d): %v", bidder, amount, err)
+	}
+	return resp
+}
+
+func TestReplication(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	if resp := placeBid(t, c.Client(1), "Alice", 5); resp.Message != "success" {
+		t.Fatalf("Bid(Alice, 5) = %s, %s", resp.Message, resp.Reason)
+	}
+	for id := 1; id <= 3; id++ {
+		eventually(t, "the bid reaches every node", func() bool { return c.highest(id) == "5" })
+	}
+
+	// A bid at or below the highest one known anywhere fails.
+	if resp := placeBid(t, c.Client(2), "Bob", 5); resp.Message != "fail" {
+		t.Errorf("Bid(Bob, 5) on node 2 = %s, want fail", resp.Message)
+	}
+}
+
+func TestFailover(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	placeBid(t, c.Client(1), "Alice", 5)
+	eventually(t, "node 2 has the bid", func() bool { return c.highest(2) == "5" })
+	c.Crash(1)
+
+	if resp := placeBid(t, c.Client(2), "Bob", 7); resp.Message != "success" {
+		t.Fatalf("Bid(Bob, 7) on node 2 = %s, %s", resp.Message, resp.Reason)
+	}
+	eventually(t, "node 3 has Bob's bid", func() bool { return c.highest(3) == "7" })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := c.Client(1).Result(ctx, &pb.ResultRequest{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Result on crashed node 1: %v, want Unavailable", err)
+	}
+}
+
+func TestHintedHandoff(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	c.Partition([]int{1, 2}, []int{3})
+	placeBid(t, c.Client(1), "Alice", 5)
+	placeBid(t, c.Client(2), "Bob", 6)
+	eventually(t, "both bids are hinted for node 3", func() bool {
+		return c.Server(1).nodes[1].hints.len() > 0 && c.Server(2).nodes[1].hints.len() > 0
+	})
+	if got := c.highest(3); got != "0" {
+		t.Fatalf("node 3 reports %q while partitioned, want 0", got)
+	}
+
+	c.Heal()
+	c.Server(1).checkPeers()
+	c.Server(2).checkPeers()
+	eventually(t, "the hints are handed off to node 3", func() bool { return c.highest(3) == "6" })
+}
+
+func TestHintsSurviveRestart(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	c.Partition([]int{1, 2}, []int{3})
+	placeBid(t, c.Client(1), "Alice", 5)
+	eventually(t, "the bid is hinted for node 3", func() bool { return c.Server(1).nodes[1].hints.len() > 0 })
+
+	// Node 1 goes down before it can hand off its hints, and hands them
+	// off from its hint file once it is back.
+	c.Crash(1)
+	c.Heal()
+	if err := c.Restart(1); err != nil {
+		t.Fatal(err)
+	}
+	c.Server(1).checkPeers()
+	eventually(t, "node 3 gets the hinted bid", func() bool { return c.highest(3) == "5" })
+}
+
+func TestCrashAfterBid(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	// Node 1 crashes right after the bid returns, before replicating it or
+	// storing it as a hint. It sends the bid again from its state log once
+	// it is back.
+	c.Delay(1, 2, time.Second)
+	c.Delay(1, 3, time.Second)
+	placeBid(t, c.Client(1), "Alice", 5)
+	c.Crash(1)
+	c.Heal()
+	if err := c.Restart(1); err != nil {
+		t.Fatal(err)
+	}
+	for id := 2; id <= 3; id++ {
+		eventually(t, "the bid reaches the other nodes", func() bool { return c.highest(id) == "5" })
+	}
+}
+
+func TestLostResponse(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	// The bid is applied, but Alice never hears back.
+	c.DropResponses(ClientID, 1, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := c.Client(1).Bid(ctx, &pb.BidRequest{Bidder: "Alice", Amount: 5}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Bid with its response lost: %v, want Unavailable", err)
+	}
+	for id := 2; id <= 3; id++ {
+		eventually(t, "the bid whose response was lost is replicated", func() bool { return c.highest(id) == "5" })
+	}
+
+	// Retrying the same bid elsewhere fails, since it already took effect.
+	c.Heal()
+	if resp := placeBid(t, c.Client(2), "Alice", 5); resp.Message != "fail" {
+		t.Errorf("retried Bid(Alice, 5) = %s, want fail", resp.Message)
+	}
+}
+
+func TestPartitionedBidsConverge(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	// Both sides accept a bid of the same amount. Once the partition heals,
+	// every node picks the same one.
+	c.Partition([]int{1}, []int{2, 3})
+	placeBid(t, c.Client(1), "Alice", 10)
+	placeBid(t, c.Client(2), "Bob", 10)
+	c.Heal()
+	for id := 1; id <= 3; id++ {
+		c.Server(id).checkPeers()
+	}
+
+	winner := func(id int) string {
+		s := c.Server(id)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.auctions[defaultAuction].highest.bidder
+	}
+	eventually(t, "all nodes agree on the winner", func() bool {
+		w := winner(1)
+		return w != "" && winner(2) == w && winner(3) == w
+	})
+}
+
+func TestDroppedReplication(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+
+	// Half of the Replicate calls from node 1 to node 2 are lost, on the
+	// way there or back. Node 2 still ends up with every bid, each applied
+	// once.
+	c.Drop(1, 2, 0.5)
+	for amount := int32(1); amount <= 10; amount++ {
+		placeBid(t, c.Client(1), "Alice", amount)
+	}
+	c.Heal()
+	c.Server(1).checkPeers()
+	eventually(t, "node 2 has every bid", func() bool { return c.highest(2) == "10" })
+
+	s := c.Server(2)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n := len(s.auctions[defaultAuction].bids); n != 10 {
+		t.Errorf("node 2 applied %d bids, want 10", n)
+	}
+}
+
+func TestRestartStartsNewEpoch(t *testing.T) {
+	// The clock reads the same after the restart, so only the incarnation
+	// tells the node's messages from those it sent before.
+	c := newCluster(t, 3, clock.NewFake(time.Unix(0, 0)))
+
+	placeBid(t, c.Client(1), "Alice", 6)
+	eventually(t, "node 2 has the bid", func() bool { return c.highest(2) == "6" })
+	if err := c.Restart(1); err != nil {
+		t.Fatal(err)
+	}
+	placeBid(t, c.Client(1), "Bob", 100)
+	eventually(t, "node 2 has the bid made after the restart", func() bool { return c.highest(2) == "100" })
+}
+
+func TestShutdownKeepsState(t *testing.T) {
+	c := newCluster(t, 3, clock.Real)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Replication to node 3 is slow, so node 1 shuts down before it has
+	// delivered anything there.
+	c.Delay(1, 3, time.Second)
+	if _, err := c.Client(1).CreateAuction(ctx, &pb.CreateAuctionRequest{
+		Auction: "lot",
+		Close:   &pb.CreateAuctionRequest_Duration{Duration: durationpb.New(time.Hour)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := c.Client(1).Bid(ctx, &pb.BidRequest{Auction: "lot", Bidder: "Bob", Amount: 7}); err != nil || resp.Message != "success" {
+		t.Fatalf("Bid(Bob, 7) in lot = %v, %v", resp, err)
+	}
+	placeBid(t, c.Client(1), "Alice", 5)
+
+	flushCtx, cancelFlush := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancelFlush()
+	c.Shutdown(flushCtx, 1)
+	c.Heal()
+	if err := c.Restart(1); err != nil {
+		t.Fatal(err)
+	}
+
+	lot := func(id int) string {
+		resp, err := c.Client(id).Result(ctx, &pb.ResultRequest{Auction: "lot"})
+		if err != nil {
+			return err.Error()
+		}
+		return resp.Highestbid
+	}
+	if got := lot(1); got != "7" {
+		t.Errorf("node 1 reports %q for lot after the restart, want 7", got)
+	}
+	if got := c.highest(1); got != "5" {
+		t.Errorf("node 1 reports %q for the default auction after the restart, want 5", got)
+	}
+
+	// What was still queued for node 3 is sent again from node 1's state
+	// log.
+	c.Server(1).checkPeers()
+	eventually(t, "node 3 gets the auction and both bids", func() bool { return lot(3) == "7" && c.highest(3) == "5" })
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// available returns an Unavailable error once the node is shutting down,
+// so that clients take new work to another node.
+// s.mu must be held.
+func (s *AuctionServer) available() error {
+	if s.draining {
+		return status.Error(codes.Unavailable, "node is shutting down")
+	}
+	return nil
+}
+
+// Shutdown takes the node out of the cluster without losing anything it
+// acknowledged. It stops taking new work, delivers what it replicated to
+// each peer or stores it as hints, which are synced to disk and handed off
+// once the node is back, tells the peers it is leaving and then stops
+// grpcServer once the calls in progress finish. If ctx is done first, it
+// stops grpcServer at once. Messages still queued for a peer then are sent
+// again from the state log when the node is back. Stop must follow.
+func (s *AuctionServer) Shutdown(ctx context.Context, grpcServer *grpc.Server) {
+	s.mu.Lock()
+	s.draining = true
+	nodes := s.nodes
+	s.mu.Unlock()
+	s.health.Shutdown()
+	s.log.Info("shutting down")
+
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := node.flush(ctx); err != nil {
+				node.log.Warn("failed to flush replication, sending the rest again after a restart", "queued", len(node.queue), "err", err)
+				return
+			}
+			if err := node.leave(ctx, s.nodeID); err != nil {
+				node.log.Warn("failed to announce leaving", "err", err)
+			}
+			node.log.Info("flushed replication", "hints", node.hints.len())
+		}()
+	}
+	wg.Wait()
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.log.Warn("calls still in progress, stopping anyway", "err", ctx.Err())
+		grpcServer.Stop()
+	}
+}
+
+// Leave marks the node that is shutting down as down, so that messages for
+// it go straight to its hints until a health check finds it up again.
+func (s *peerServer) Leave(ctx context.Context, req *pb.LeaveRequest) (*pb.LeaveResponse, error) {
+	if err := s.authenticatePeer(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, err := s.hlc.update(fromProto(req.Timestamp))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	for _, node := range s.nodes {
+		if node.nodeID != int(req.Node) {
+			continue
+		}
+		node.left.Store(true)
+		node.active.Store(false)
+		peerUp.WithLabelValues(node.name()).Set(0)
+		node.log.Info("peer is leaving")
+	}
+	return &pb.LeaveResponse{Timestamp: ts.proto()}, nil
+}
@@ -0,0 +1,639 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+	"MandatoryActivity5/history"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ClientID is the endpoint ID of clients, so faults can be injected between
+// clients and nodes as well as between nodes.
+const ClientID = 0
+
+// link is the direction of traffic from one endpoint to another.
+type link struct {
+	from, to int
+}
+
+const (
+	simNodes    = 3
+	simMaxSteps = 200000
+	// simSettle is how long the network is left healed after the bidders
+	// stop, so pending replication and hints can reach every live node.
+	simSettle = time.Minute
+	// simClusterSecret is the cluster secret of the simulated nodes.
+	simClusterSecret = "sim"
+)
+
+// simulation runs a cluster of AuctionServers and a set of bidders in a
+// single goroutine. Every choice — which bidder or node acts next, which
+// node a bidder talks to, which messages are lost and when faults strike —
+// comes from one seeded random source, time is virtual, and messages
+// between nodes are method calls through a simulated network. Running the
+// same seed again therefore replays exactly the same interleaving of Bid,
+// Result, replication and health checks.
+type simulation struct {
+	seed     int64
+	rng      *rand.Rand
+	clock    *clock.Fake
+	start    time.Time
+	dir      string
+	trace    io.Writer
+	nodes    []*simNode
+	bidders  []*simBidder
+	recorder *history.Recorder
+
+	partitioned map[link]bool
+	dropRate    float64
+	// down is the node that has crashed and not restarted yet, if any.
+	down      int
+	crashes   int
+	restarts  int
+	settling  bool
+	settleEnd time.Time
+	// err is set if a node could not be started, which ends the run.
+	err error
+}
+
+type simNode struct {
+	id        int
+	server    *AuctionServer
+	nextCheck time.Time
+}
+
+type simBidder struct {
+	name string
+	end  time.Time
+	next time.Time
+	// node and highest are set by the Result a bidder has made while its
+	// Bid is pending.
+	node       int
+	highest    int32
+	bidPending bool
+	done       bool
+}
+
+type simAction struct {
+	desc string
+	run  func()
+}
+
+// simOutcome is how a simulation ended.
+type simOutcome struct {
+	seed  int64
+	steps int
+	// highest is the highest bid in the default auction on each node.
+	highest []bid
+	// bids is the number of Bids that succeeded, and crashes and restarts
+	// the number of times a node crashed and came back.
+	bids     int
+	crashes  int
+	restarts int
+	agreed   bool
+	// mismatch is set if the nodes agreed on a winner that the bids made
+	// do not explain.
+	mismatch error
+	// linearizable is the verdict of the linearizability checker. It is
+	// only informative: replication is asynchronous, so a node may answer
+	// a Result before it has heard of a bid another node accepted.
+	linearizable error
+	// err is set if the run or the checker failed.
+	err error
+}
+
+// ok reports whether the nodes agreed on a winner the bids explain.
+func (o simOutcome) ok() bool {
+	return o.agreed && o.mismatch == nil && o.err == nil
+}
+
+func (o simOutcome) String() string {
+	if o.err != nil {
+		return fmt.Sprintf("seed %d: FAILED after %d steps: %v", o.seed, o.steps, o.err)
+	}
+	var nodes []string
+	for i, highest := range o.highest {
+		nodes = append(nodes, fmt.Sprintf("node %d: %s with %d", i+1, highest.bidder, highest.amount))
+	}
+	verdict := "agreed"
+	switch {
+	case !o.agreed:
+		verdict = "DIVERGED"
+	case o.mismatch != nil:
+		verdict = fmt.Sprintf("agreed on a WRONG WINNER (%v)", o.mismatch)
+	}
+	linearizable := "linearizable"
+	if o.linearizable != nil {
+		linearizable = "not linearizable"
+	}
+	return fmt.Sprintf("seed %d: %s after %d steps (%s), %d bids, %d crashes, %d restarts, history %s",
+		o.seed, verdict, o.steps, strings.Join(nodes, ", "), o.bids, o.crashes, o.restarts, linearizable)
+}
+
+// runSimulations simulates runs seeds starting from seed, printing a
+// summary of each, and the full trace when there is a single run. It
+// returns the process exit status: 1 if any run ended with the nodes
+// disagreeing, agreeing on a winner the bids do not explain, or failing.
+// Whether the history was linearizable is only reported.
+func runSimulations(seed int64, runs int) int {
+	status := 0
+	for i := 0; i < runs; i++ {
+		trace := io.Writer(os.Stdout)
+		if runs > 1 {
+			trace = io.Discard
+		}
+		outcome := simulate(seed+int64(i), trace)
+		fmt.Println(outcome)
+		if !outcome.ok() {
+			status = 1
+		}
+	}
+	return status
+}
+
+// simulate runs one simulation and returns how it ended.
+func simulate(seed int64, trace io.Writer) simOutcome {
+	dir, err := os.MkdirTemp("", "auction-sim")
+	if err != nil {
+		return simOutcome{seed: seed, err: err}
+	}
+	defer os.RemoveAll(dir)
+
+	start := time.Date(2024, 11, 25, 20, 0, 0, 0, time.UTC)
+	sim := &simulation{
+		seed:        seed,
+		rng:         rand.New(rand.NewSource(seed)),
+		clock:       clock.NewFake(start),
+		start:       start,
+		dir:         dir,
+		trace:       trace,
+		partitioned: map[link]bool{},
+		dropRate:    0.05,
+	}
+	sim.recorder = history.NewRecorder(nil, sim.clock)
+
+	log.SetOutput(simLog{sim})
+	log.SetFlags(0)
+	defer func() {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(log.LstdFlags)
+	}()
+	// The nodes log to the trace, without wall-clock times, so that the
+	// trace is the same every time.
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(simLog{sim}, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+	defer slog.SetDefault(defaultLogger)
+
+	defer func() {
+		for _, node := range sim.nodes {
+			if node.server != nil {
+				node.server.Stop()
+			}
+		}
+	}()
+	for id := 1; id <= simNodes; id++ {
+		sim.nodes = append(sim.nodes, &simNode{id: id})
+		if err := sim.startNode(id); err != nil {
+			return simOutcome{seed: seed, err: err}
+		}
+	}
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		sim.bidders = append(sim.bidders, &simBidder{
+			name: name,
+			end:  start.Add(100 * time.Second),
+			next: start.Add(sim.think()),
+		})
+	}
+
+	return sim.verdict(sim.run())
+}
+
+// run executes actions until the bidders are done and the cluster has
+// settled, and returns the number of steps taken.
+func (sim *simulation) run() int {
+	for step := 0; step < simMaxSteps; step++ {
+		if sim.err != nil {
+			return step
+		}
+		if !sim.settling && sim.biddersDone() {
+			sim.settling = true
+			sim.settleEnd = sim.clock.Now().Add(simSettle)
+			sim.partitioned = map[link]bool{}
+			sim.dropRate = 0
+			sim.tracef("bidders are done, healing the network and settling for %s", simSettle)
+			if sim.down != 0 {
+				sim.restart()
+			}
+		}
+		if sim.settling && !sim.clock.Now().Before(sim.settleEnd) {
+			return step
+		}
+
+		actions := sim.enabled()
+		if len(actions) == 0 {
+			sim.advance()
+			continue
+		}
+		// Let a little time pass now and then, as network latency would.
+		if sim.rng.Intn(4) == 0 {
+			sim.clock.Advance(time.Duration(sim.rng.Intn(100)+1) * time.Millisecond)
+			continue
+		}
+		if !sim.settling && sim.rng.Intn(50) == 0 {
+			sim.fault()
+			continue
+		}
+
+		action := actions[sim.rng.Intn(len(actions))]
+		sim.tracef("%s", action.desc)
+		action.run()
+	}
+	sim.tracef("giving up after %d steps", simMaxSteps)
+	return simMaxSteps
+}
+
+// enabled lists the actions that can happen at the current virtual time,
+// always in the same order.
+func (sim *simulation) enabled() []simAction {
+	now := sim.clock.Now()
+	var actions []simAction
+
+	for _, b := range sim.bidders {
+		b := b
+		switch {
+		case b.done:
+		case b.bidPending:
+			actions = append(actions, simAction{
+				desc: fmt.Sprintf("%s bids %d on node %d", b.name, b.highest+1, b.node),
+				run:  func() { sim.bid(b) },
+			})
+		case !now.Before(b.next):
+			actions = append(actions, simAction{
+				desc: fmt.Sprintf("%s asks for the result", b.name),
+				run:  func() { sim.result(b) },
+			})
+		}
+	}
+
+	for _, n := range sim.nodes {
+		if n.server == nil {
+			continue
+		}
+		n := n
+		if !now.Before(n.nextCheck) {
+			actions = append(actions, simAction{
+				desc: fmt.Sprintf("node %d checks its peers", n.id),
+				run: func() {
+					n.server.checkPeers()
+					n.nextCheck = n.nextCheck.Add(10 * time.Second)
+				},
+			})
+		}
+		for _, peer := range n.server.nodes {
+			if peer.pending() {
+				peer := peer
+				actions = append(actions, simAction{
+					desc: fmt.Sprintf("node %d replicates to node %d", n.id, peer.nodeID),
+					run:  func() { peer.step() },
+				})
+			}
+		}
+	}
+	return actions
+}
+
+// advance moves virtual time to the next moment something is due.
+func (sim *simulation) advance() {
+	now := sim.clock.Now()
+	next := sim.settleEnd
+	if !sim.settling {
+		next = now.Add(time.Hour)
+	}
+	if t, ok := sim.clock.Next(); ok && t.Before(next) {
+		next = t
+	}
+	for _, b := range sim.bidders {
+		if !b.done && !b.bidPending && b.next.Before(next) {
+			next = b.next
+		}
+	}
+	for _, n := range sim.nodes {
+		if n.server != nil && n.nextCheck.Before(next) {
+			next = n.nextCheck
+		}
+	}
+	sim.clock.Advance(max(next.Sub(now), time.Millisecond))
+}
+
+// fault injects a random fault: a partition between two nodes, healing
+// every partition, or, as long as the other two nodes are up, crashing a
+// node. A crashed node restarts at a later fault of that kind, and at the
+// latest once the bidders are done.
+func (sim *simulation) fault() {
+	a := sim.rng.Intn(simNodes) + 1
+	b := (a+sim.rng.Intn(simNodes-1))%simNodes + 1
+	switch sim.rng.Intn(3) {
+	case 0:
+		sim.tracef("fault: partition between node %d and node %d", a, b)
+		sim.partitioned[link{a, b}] = true
+		sim.partitioned[link{b, a}] = true
+	case 1:
+		sim.tracef("fault: heal all partitions")
+		sim.partitioned = map[link]bool{}
+	case 2:
+		if sim.down != 0 {
+			sim.restart()
+			return
+		}
+		node := sim.nodes[a-1]
+		sim.down = a
+		sim.crashes++
+		sim.tracef("fault: node %d crashes", a)
+		node.server.Stop()
+		node.server = nil
+	}
+}
+
+// restart starts the crashed node again from its files.
+func (sim *simulation) restart() {
+	id := sim.down
+	sim.down = 0
+	sim.restarts++
+	sim.tracef("node %d restarts", id)
+	if err := sim.startNode(id); err != nil {
+		sim.err = fmt.Errorf("restarting node %d: %w", id, err)
+	}
+}
+
+func (sim *simulation) startNode(id int) error {
+	server := newAuctionServer(id, sim.clock)
+	server.clusterSecret = simClusterSecret
+	epoch, err := nextIncarnation(filepath.Join(sim.dir, fmt.Sprintf("incarnation-%d", id)))
+	if err != nil {
+		return err
+	}
+	server.epoch = epoch
+	if err := server.openAcceptors(filepath.Join(sim.dir, fmt.Sprintf("paxos-%d.log", id))); err != nil {
+		return err
+	}
+	if err := server.openState(filepath.Join(sim.dir, fmt.Sprintf("state-%d.log", id))); err != nil {
+		return err
+	}
+	for peer := 1; peer <= simNodes; peer++ {
+		if peer == id {
+			continue
+		}
+		node := server.newNode(peer, fmt.Sprintf("sim%d", peer))
+		node.client = simClient{sim: sim, from: id, to: peer}
+		node.health = simHealth{sim: sim, from: id, to: peer}
+		if err := node.open(filepath.Join(sim.dir, fmt.Sprintf("hints-%d-%d.log", id, peer)), filepath.Join(sim.dir, fmt.Sprintf("delivered-%d-%d", id, peer))); err != nil {
+			return err
+		}
+		node.resend(server.sent)
+		server.nodes = append(server.nodes, node)
+	}
+
+	n := sim.nodes[id-1]
+	n.server = server
+	n.nextCheck = sim.clock.Now().Add(10 * time.Second)
+	return nil
+}
+
+func (sim *simulation) result(b *simBidder) {
+	if !sim.clock.Now().Before(b.end) {
+		b.done = true
+		return
+	}
+
+	b.node = sim.rng.Intn(simNodes) + 1
+	c := simClient{sim: sim, from: ClientID, to: b.node}
+	resp, err := sim.recorder.Result(context.Background(), c, b.name, &pb.ResultRequest{})
+	if err != nil {
+		sim.tracef("%s could not get the result from node %d: %v", b.name, b.node, err)
+		b.next = sim.clock.Now().Add(sim.think())
+		return
+	}
+	sim.tracef("%s got %q from node %d", b.name, resp.Highestbid, b.node)
+
+	if strings.HasPrefix(resp.Highestbid, "Auction over.") {
+		b.done = true
+		return
+	}
+	highest, err := strconv.Atoi(resp.Highestbid)
+	if err != nil {
+		b.next = sim.clock.Now().Add(sim.think())
+		return
+	}
+	b.highest = int32(highest)
+	b.bidPending = true
+}
+
+func (sim *simulation) bid(b *simBidder) {
+	b.bidPending = false
+	b.next = sim.clock.Now().Add(sim.think())
+
+	c := simClient{sim: sim, from: ClientID, to: b.node}
+	resp, err := sim.recorder.Bid(context.Background(), c, b.name, &pb.BidRequest{Bidder: b.name, Amount: b.highest + 1})
+	if err != nil {
+		sim.tracef("%s could not bid on node %d: %v", b.name, b.node, err)
+		return
+	}
+	sim.tracef("%s's bid of %d on node %d: %s", b.name, b.highest+1, b.node, resp.Message)
+}
+
+// think returns how long a bidder waits between rounds, 1 to 5 seconds as
+// in Client.go.
+func (sim *simulation) think() time.Duration {
+	return time.Duration(sim.rng.Intn(5)+1) * time.Second
+}
+
+func (sim *simulation) biddersDone() bool {
+	for _, b := range sim.bidders {
+		if !b.done {
+			return false
+		}
+	}
+	return true
+}
+
+// verdict compares the outcome on every node, checks that it is the
+// highest bid made and checks the recorded history for linearizability.
+func (sim *simulation) verdict(steps int) simOutcome {
+	o := simOutcome{
+		seed:     sim.seed,
+		steps:    steps,
+		crashes:  sim.crashes,
+		restarts: sim.restarts,
+		agreed:   true,
+		err:      sim.err,
+	}
+	if o.err != nil {
+		return o
+	}
+	for _, n := range sim.nodes {
+		n.server.mu.Lock()
+		highest := n.server.auctions[defaultAuction].highest
+		n.server.mu.Unlock()
+		if len(o.highest) > 0 && (highest.bidder != o.highest[0].bidder || highest.amount != o.highest[0].amount) {
+			o.agreed = false
+		}
+		o.highest = append(o.highest, highest)
+	}
+
+	ops := sim.recorder.Operations()
+	for _, op := range ops {
+		if op.Kind == history.Bid && op.Error == "" && op.Outcome == "success" {
+			o.bids++
+		}
+	}
+	if o.agreed {
+		o.mismatch = decided(ops, o.highest[0])
+	}
+
+	err := history.Check(ops)
+	if nonLinearizable := (*history.NonLinearizableError)(nil); errors.As(err, &nonLinearizable) {
+		o.linearizable = err
+	} else {
+		o.err = err
+	}
+	if err != nil {
+		sim.tracef("%v", err)
+	}
+	return o
+}
+
+// decided reports whether the bids in ops explain winner: it must be at
+// least the highest bid that succeeded, and a bid that was made, either
+// one that succeeded or one whose outcome is unknown.
+func decided(ops []history.Operation, winner bid) error {
+	var acknowledged int32
+	made := map[bid]bool{}
+	for _, op := range ops {
+		if op.Kind != history.Bid || op.Auction != defaultAuction {
+			continue
+		}
+		switch {
+		case op.Error != "":
+		case op.Outcome == "success":
+			acknowledged = max(acknowledged, op.Amount)
+		default:
+			continue
+		}
+		made[bid{bidder: op.Bidder, amount: op.Amount}] = true
+	}
+	switch {
+	case winner.amount < acknowledged:
+		return fmt.Errorf("%d is below the acknowledged bid of %d", winner.amount, acknowledged)
+	case winner.amount == 0 && winner.bidder == "":
+		return nil
+	case !made[bid{bidder: winner.bidder, amount: winner.amount}]:
+		return fmt.Errorf("%s never bid %d", winner.bidder, winner.amount)
+	}
+	return nil
+}
+
+// reach returns the server a call from one endpoint to another arrives
+// at, or an error if the call is lost.
+func (sim *simulation) reach(from, to int) (*AuctionServer, error) {
+	server := sim.nodes[to-1].server
+	if server == nil {
+		return nil, status.Errorf(codes.Unavailable, "sim: node %d is down", to)
+	}
+	if sim.partitioned[link{from, to}] {
+		return nil, status.Errorf(codes.Unavailable, "sim: node %d is partitioned from %d", to, from)
+	}
+	if from != ClientID && sim.dropRate > 0 && sim.rng.Float64() < sim.dropRate {
+		return nil, status.Errorf(codes.Unavailable, "sim: message from %d to %d lost", from, to)
+	}
+	return server, nil
+}
+
+func (sim *simulation) tracef(format string, args ...any) {
+	elapsed := sim.clock.Now().Sub(sim.start).Seconds()
+	fmt.Fprintf(sim.trace, "[%9.3fs] %s\n", elapsed, fmt.Sprintf(format, args...))
+}
+
+// simLog sends the servers' log output to the trace, stamped with virtual
+// time.
+type simLog struct {
+	sim *simulation
+}
+
+func (l simLog) Write(p []byte) (int, error) {
+	l.sim.tracef("  %s", strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+// simClient calls a server directly through the simulated network. Only
+// the methods used by bidders and replication are implemented.
+type simClient struct {
+	pb.AuctionClient
+	pb.PeerClient
+	sim      *simulation
+	from, to int
+}
+
+func (c simClient) Bid(ctx context.Context, req *pb.BidRequest, opts ...grpc.CallOption) (*pb.BidResponse, error) {
+	server, err := c.sim.reach(c.from, c.to)
+	if err != nil {
+		return nil, err
+	}
+	return server.Bid(ctx, req)
+}
+
+func (c simClient) Result(ctx context.Context, req *pb.ResultRequest, opts ...grpc.CallOption) (*pb.ResultResponse, error) {
+	server, err := c.sim.reach(c.from, c.to)
+	if err != nil {
+		return nil, err
+	}
+	return server.Result(ctx, req)
+}
+
+func (c simClient) Replicate(ctx context.Context, req *pb.ReplicateRequest, opts ...grpc.CallOption) (*pb.ReplicateResponse, error) {
+	server, err := c.sim.reach(c.from, c.to)
+	if err != nil {
+		return nil, err
+	}
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+simClusterSecret))
+	return (&peerServer{AuctionServer: server}).Replicate(ctx, req)
+}
+
+// simHealth answers health checks for the nodes it can reach.
+type simHealth struct {
+	healthpb.HealthClient
+	sim      *simulation
+	from, to int
+}
+
+func (h simHealth) Check(ctx context.Context, req *healthpb.HealthCheckRequest, opts ...grpc.CallOption) (*healthpb.HealthCheckResponse, error) {
+	if _, err := h.sim.reach(h.from, h.to); err != nil {
+		return nil, err
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"MandatoryActivity5/history"
+)
+
+func TestSimulationIsDeterministic(t *testing.T) {
+	var first, second bytes.Buffer
+	summary := simulate(1, &first).String()
+	again := simulate(1, &second).String()
+	if summary != again {
+		t.Errorf("summaries of seed 1 differ:\n%s\n%s", summary, again)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("traces of seed 1 differ")
+	}
+}
+
+func TestSimulationAgrees(t *testing.T) {
+	for _, tt := range []struct {
+		seed     int64
+		winner   string
+		amount   int32
+		bids     int
+		restarts int
+	}{
+		{seed: 1, winner: "Alice", amount: 68, bids: 79, restarts: 1},
+		{seed: 2, winner: "Carol", amount: 68, bids: 93, restarts: 1},
+		{seed: 3, winner: "Alice", amount: 87, bids: 97, restarts: 0},
+	} {
+		o := simulate(tt.seed, io.Discard)
+		if !o.ok() {
+			t.Errorf("%s", o)
+			continue
+		}
+		for id, highest := range o.highest {
+			if highest.bidder != tt.winner || highest.amount != tt.amount {
+				t.Errorf("seed %d: node %d has %s with %d, want %s with %d", tt.seed, id+1, highest.bidder, highest.amount, tt.winner, tt.amount)
+			}
+		}
+		if len(o.highest) != simNodes {
+			t.Errorf("seed %d: %d nodes reported, want %d", tt.seed, len(o.highest), simNodes)
+		}
+		if o.bids != tt.bids {
+			t.Errorf("seed %d: %d bids succeeded, want %d", tt.seed, o.bids, tt.bids)
+		}
+		if o.crashes != tt.restarts || o.restarts != tt.restarts {
+			t.Errorf("seed %d: %d crashes and %d restarts, want %d of each", tt.seed, o.crashes, o.restarts, tt.restarts)
+		}
+	}
+}
+
+func TestDecided(t *testing.T) {
+	made := func(bidder string, amount int32, outcome, err string) history.Operation {
+		return history.Operation{Kind: history.Bid, Bidder: bidder, Amount: amount, Outcome: outcome, Error: err}
+	}
+	ops := []history.Operation{
+		made("Alice", 5, "success", ""),
+		made("Bob", 7, "", "unavailable"),
+		made("Carol", 9, "fail", ""),
+	}
+	for _, tt := range []struct {
+		winner bid
+		ok     bool
+	}{
+		{winner: bid{bidder: "Alice", amount: 5}, ok: true},
+		// Bob's bid may have taken effect before its response was lost.
+		{winner: bid{bidder: "Bob", amount: 7}, ok: true},
+		{winner: bid{}, ok: false},
+		{winner: bid{bidder: "Carol", amount: 9}, ok: false},
+		{winner: bid{bidder: "Bob", amount: 5}, ok: false},
+	} {
+		if err := decided(ops, tt.winner); (err == nil) != tt.ok {
+			t.Errorf("decided(%s with %d) = %v, want ok %t", tt.winner.bidder, tt.winner.amount, err, tt.ok)
+		}
+	}
+}
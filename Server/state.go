@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"sync"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// stateLog keeps every replication message the node has applied, its own
+// and those of its peers, in an append-only file, so that the node comes
+// back from a restart with the auctions it knew of. A message is written
+// before the node acknowledges it: peers only stop resending it once it is
+// safe here. A nil *stateLog keeps nothing.
+type stateLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// openStateLog loads the messages left in path by previous runs, in the
+// order they were applied, and opens the file for appending.
+func openStateLog(path string) (*stateLog, []*pb.ReplicateRequest, error) {
+	var msgs []*pb.ReplicateRequest
+	f, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+	if err == nil {
+		r := bufio.NewReader(f)
+		for {
+			msg := &pb.ReplicateRequest{}
+			// Stop at EOF or at a torn write left by a crash. The message
+			// being written then had not been acknowledged yet.
+			if err := protodelim.UnmarshalFrom(r, msg); err != nil {
+				break
+			}
+			msgs = append(msgs, msg)
+		}
+		f.Close()
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &stateLog{file: file}, msgs, nil
+}
+
+// save stores msg durably.
+func (l *stateLog) save(msg *pb.ReplicateRequest) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := protodelim.MarshalTo(l.file, msg); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+func (l *stateLog) close() error {
+	if l == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+// openState applies again the messages previous runs of the node left in
+// path, and keeps the new ones there. The first run also stores the terms
+// of its default auction, so that after a restart it still closes when it
+// would have. It must be called before peers are added, which are sent
+// again the messages of this node's that they may have missed.
+func (s *AuctionServer) openState(path string) error {
+	l, msgs, err := openStateLog(path)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state = l
+	if len(msgs) == 0 {
+		a := s.auctions[defaultAuction]
+		return l.save(&pb.ReplicateRequest{
+			Origin: int32(s.nodeID),
+			Op:     &pb.ReplicateRequest_Create{Create: a.terms.proto(defaultAuction, a.createdAt)},
+		})
+	}
+
+	ctx := context.Background()
+	for _, msg := range msgs {
+		ts := fromProto(msg.Timestamp)
+		if _, err := s.hlc.update(ts); err != nil {
+			s.log.Warn("restored a message from ahead of the clock", "origin", msg.Origin, "seq", msg.Seq, "err", err)
+		}
+		switch {
+		case msg.Origin != int32(s.nodeID):
+			s.applied[msg.Origin] = appliedSeq{epoch: msg.Epoch, seq: msg.Seq}
+		case msg.Seq > 0:
+			// Unlike the default auction, this was replicated.
+			s.sent = append(s.sent, msg)
+		}
+		if create := msg.GetCreate(); create != nil && create.Auction == defaultAuction {
+			// The first run's default auction replaces the one this run
+			// opened when it started.
+			s.auctions[defaultAuction] = &auction{id: defaultAuction}
+		}
+		s.apply(ctx, msg, ts)
+	}
+	s.log.Info("restored state", "messages", len(msgs), "auctions", len(s.auctions))
+	return nil
+}
@@ -0,0 +1,399 @@
+package history
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// phase is where an auction is in its life.
+type phase int
+
+const (
+	notStarted phase = iota
+	open
+	closed
+	cancelled
+)
+
+// over reports whether the auction has closed or been cancelled.
+func (p phase) over() bool {
+	return p == closed || p == cancelled
+}
+
+// state is the sequential model of an auction with the given terms: an
+// English auction accepts a bid that raises the highest by at least the
+// increment of its price band, or meets the opening price if it is the
+// first, and a sealed-bid one any bid that meets the opening price. The
+// auction opens, closes or is cancelled at some point, since the calls
+// that decide when are not recorded; bids fail before and after.
+type state struct {
+	phase   phase
+	highest int32
+	bidder  string
+	// maxima are the highest bids of each bidder, from which the price of
+	// a Vickrey auction follows. They are kept for Vickrey auctions only.
+	maxima map[string]int32
+}
+
+// model is an auction's terms, which its states are read with.
+type model struct {
+	Terms
+}
+
+func (m model) sealed() bool {
+	return m.Mode == "SEALED_FIRST_PRICE" || m.Mode == "VICKREY"
+}
+
+// minimum returns the lowest amount a bid must have in s.
+func (m model) minimum(s state) int32 {
+	if s.highest == 0 || m.sealed() {
+		return max(m.Opening, 1)
+	}
+	step := int32(1)
+	for _, inc := range m.Increments {
+		if s.highest >= inc.From {
+			step = inc.Step
+		}
+	}
+	return s.highest + step
+}
+
+// price returns what the highest bidder pays in s: their bid, or in a
+// Vickrey auction the highest bid of anyone else, but no less than the
+// reserve or opening price.
+func (m model) price(s state) int32 {
+	if m.Mode != "VICKREY" {
+		return s.highest
+	}
+	price := max(m.Reserve, m.Opening, 1)
+	for bidder, amount := range s.maxima {
+		if bidder != s.bidder {
+			price = max(price, amount)
+		}
+	}
+	return min(price, s.highest)
+}
+
+// accept returns s after a bid of amount by bidder. In a sealed-bid
+// auction an earlier bid of the same amount stays the highest.
+func (m model) accept(s state, bidder string, amount int32) state {
+	if m.Mode == "VICKREY" {
+		maxima := maps.Clone(s.maxima)
+		if maxima == nil {
+			maxima = map[string]int32{}
+		}
+		maxima[bidder] = max(maxima[bidder], amount)
+		s.maxima = maxima
+	}
+	if amount > s.highest || !m.sealed() {
+		s.highest, s.bidder = amount, bidder
+	}
+	return s
+}
+
+// step applies op to s and returns the states the auction can be in
+// afterwards. It returns none when op's response is impossible in s.
+func (m model) step(s state, op Operation) []state {
+	// The auction may open just before op.
+	befores := []state{s}
+	if s.phase == notStarted {
+		opened := s
+		opened.phase = open
+		befores = append(befores, opened)
+	}
+	var after []state
+	for _, s := range befores {
+		after = append(after, m.stepFrom(s, op)...)
+	}
+	return after
+}
+
+func (m model) stepFrom(s state, op Operation) []state {
+	end := func(p phase) state {
+		ended := s
+		ended.phase = p
+		return ended
+	}
+
+	switch op.Kind {
+	case Bid:
+		canAccept := s.phase == open && op.Amount >= m.minimum(s)
+		switch {
+		case op.Error != "":
+			// The bid may or may not have reached the auction.
+			if canAccept {
+				return []state{m.accept(s, op.Bidder, op.Amount), s}
+			}
+			return []state{s}
+		case op.Outcome == "success":
+			if canAccept {
+				return []state{m.accept(s, op.Bidder, op.Amount)}
+			}
+			return nil
+		default:
+			if !canAccept {
+				return []state{s}
+			}
+			// A bid that should have been accepted can only fail once the
+			// auction has closed or been cancelled, which may have happened
+			// just before it.
+			return []state{end(closed), end(cancelled)}
+		}
+	case Result:
+		switch {
+		case op.Cancelled:
+			if s.phase == closed {
+				return nil
+			}
+			return []state{end(cancelled)}
+		case s.phase == cancelled:
+			return nil
+		case op.Sealed:
+			if !m.sealed() || s.phase == closed {
+				return nil
+			}
+			return []state{s}
+		case !op.Over:
+			if m.sealed() || s.phase == closed || op.Highest != s.highest {
+				return nil
+			}
+			return []state{s}
+		case op.Reserve > 0:
+			if op.Reserve != m.Reserve || s.highest >= m.Reserve {
+				return nil
+			}
+			return []state{end(closed)}
+		default:
+			if s.highest < m.Reserve || op.Highest != s.highest || op.Winner != s.bidder || op.Price != m.price(s) {
+				return nil
+			}
+			return []state{end(closed)}
+		}
+	}
+	return nil
+}
+
+// NonLinearizableError reports a history that no sequential execution of
+// the auction explains.
+type NonLinearizableError struct {
+	// Auction is the ID of the auction.
+	Auction string
+	// Linearized is the longest prefix of a sequential execution found.
+	Linearized []Operation
+	// Pending are the operations that could not be placed after it.
+	Pending []Operation
+}
+
+func (e *NonLinearizableError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "history of %s is not linearizable: %d operations linearized, none of these can follow:", describe(e.Auction), len(e.Linearized))
+	for _, op := range e.Pending {
+		fmt.Fprintf(&b, "\n\t%s", op)
+	}
+	return b.String()
+}
+
+func describe(auction string) string {
+	if auction == "" {
+		return "the default auction"
+	}
+	return fmt.Sprintf("auction %q", auction)
+}
+
+func (op Operation) String() string {
+	var desc string
+	switch op.Kind {
+	case Bid:
+		desc = fmt.Sprintf("Bid(%s, %d) -> %s", op.Bidder, op.Amount, op.Outcome)
+		if op.MaxAmount != 0 {
+			desc = fmt.Sprintf("Bid(%s, max %d) -> %s", op.Bidder, op.MaxAmount, op.Outcome)
+		}
+	case Result:
+		switch {
+		case op.Cancelled:
+			desc = "Result() -> cancelled"
+		case op.Sealed:
+			desc = "Result() -> sealed"
+		case op.Reserve > 0:
+			desc = fmt.Sprintf("Result() -> over, reserve of %d not met", op.Reserve)
+		case op.Over:
+			desc = fmt.Sprintf("Result() -> over, %s won with %d, pays %d", op.Winner, op.Highest, op.Price)
+		default:
+			desc = fmt.Sprintf("Result() -> %d", op.Highest)
+		}
+	case Create:
+		desc = fmt.Sprintf("CreateAuction(%+v)", op.Terms)
+	}
+	if op.Error != "" {
+		desc = fmt.Sprintf("%s(...) -> error %q", op.Kind, op.Error)
+	}
+	if op.Auction != "" {
+		desc = fmt.Sprintf("%s %s", op.Auction, desc)
+	}
+	return fmt.Sprintf("%s: %s [%d, %d]", op.Process, desc, op.Call, op.Return)
+}
+
+// Check reports whether ops is linearizable with respect to the sequential
+// auction model, returning a *NonLinearizableError if it is not.
+//
+// Linearizability is local, so every auction is checked on its own, with
+// the terms its Create set. An auction without a Create in ops is taken to
+// be the default one: an English auction, open from the start, with no
+// opening or reserve price. Dutch auctions and auctions with automatic
+// bids are not modelled, and are skipped. Neither are the Admin calls: an
+// auction may close or be cancelled at any time, but a retracted bid makes
+// the history of its auction fail the check.
+//
+// The search for a sequential order is in the manner of Wing and Gong: an
+// operation may be linearized next only if it was invoked before every
+// other remaining operation returned. Failed Results tell us nothing and
+// are ignored; a failed Bid may take effect at any point after it was
+// invoked, or not at all.
+func Check(ops []Operation) error {
+	auctions := map[string][]Operation{}
+	terms := map[string]*Terms{}
+	skip := map[string]bool{}
+	for _, op := range ops {
+		switch {
+		case op.Kind == Create:
+			// A failed Create may have taken effect, unless another one
+			// for the same auction succeeded.
+			if _, ok := terms[op.Auction]; (!ok || op.Error == "") && op.Terms != nil {
+				terms[op.Auction] = op.Terms
+			}
+		case op.Kind == Bid && op.MaxAmount != 0:
+			skip[op.Auction] = true
+		case op.Kind == Result && op.Error != "":
+		default:
+			auctions[op.Auction] = append(auctions[op.Auction], op)
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(auctions)) {
+		m, initial := model{}, state{phase: open}
+		if t := terms[id]; t != nil {
+			m, initial = model{*t}, state{phase: notStarted}
+		}
+		if skip[id] || m.Mode == "DUTCH" {
+			continue
+		}
+		if err := m.check(initial, auctions[id]); err != nil {
+			err.Auction = id
+			return err
+		}
+	}
+	return nil
+}
+
+// check reports whether the operations on one auction are linearizable
+// from the state initial.
+func (m model) check(initial state, ops []Operation) *NonLinearizableError {
+	var kept []Operation
+	for _, op := range ops {
+		if op.Error != "" {
+			op.Return = math.MaxInt64
+		}
+		kept = append(kept, op)
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Call < kept[j].Call })
+
+	c := &checker{model: m, ops: kept, seen: map[string]bool{}}
+	done := make([]bool, len(kept))
+	if c.search(initial, done, nil) {
+		return nil
+	}
+
+	err := &NonLinearizableError{}
+	done = make([]bool, len(kept))
+	for _, i := range c.best {
+		err.Linearized = append(err.Linearized, kept[i])
+		done[i] = true
+	}
+	for _, i := range c.candidates(done) {
+		err.Pending = append(err.Pending, kept[i])
+	}
+	return err
+}
+
+type checker struct {
+	model model
+	ops   []Operation
+	seen  map[string]bool
+	best  []int
+}
+
+// search tries to extend the linearization order with every operation that
+// may come next, depth first. It reports whether all operations with a
+// known outcome could be linearized.
+func (c *checker) search(s state, done []bool, order []int) bool {
+	if len(order) > len(c.best) {
+		c.best = append([]int(nil), order...)
+	}
+
+	complete := true
+	for i, op := range c.ops {
+		if !done[i] && op.Error == "" {
+			complete = false
+			break
+		}
+	}
+	if complete {
+		return true
+	}
+
+	key := c.key(s, done)
+	if c.seen[key] {
+		return false
+	}
+	c.seen[key] = true
+
+	for _, i := range c.candidates(done) {
+		for _, next := range c.model.step(s, c.ops[i]) {
+			done[i] = true
+			if c.search(next, done, append(order, i)) {
+				return true
+			}
+			done[i] = false
+		}
+	}
+	return false
+}
+
+// candidates returns the remaining operations that were invoked before
+// every remaining operation returned.
+func (c *checker) candidates(done []bool) []int {
+	minReturn := int64(math.MaxInt64)
+	for i, op := range c.ops {
+		if !done[i] {
+			minReturn = min(minReturn, op.Return)
+		}
+	}
+
+	var next []int
+	for i, op := range c.ops {
+		if !done[i] && op.Call <= minReturn {
+			next = append(next, i)
+		}
+	}
+	return next
+}
+
+func (c *checker) key(s state, done []bool) string {
+	var b strings.Builder
+	for _, d := range done {
+		if d {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	fmt.Fprintf(&b, "|%d|%d|%s", s.phase, s.highest, s.bidder)
+	for _, bidder := range slices.Sorted(maps.Keys(s.maxima)) {
+		fmt.Fprintf(&b, "|%s=%d", bidder, s.maxima[bidder])
+	}
+	return b.String()
+}
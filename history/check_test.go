@@ -0,0 +1,261 @@
+package history
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// op returns an operation invoked at call and returned at ret.
+func op(process string, kind Kind, call, ret int64) Operation {
+	return Operation{Process: process, Kind: kind, Call: call, Return: ret}
+}
+
+func bid(process string, amount int32, outcome string, call, ret int64) Operation {
+	o := op(process, Bid, call, ret)
+	o.Bidder, o.Amount, o.Outcome = process, amount, outcome
+	return o
+}
+
+func result(process string, highest int32, call, ret int64) Operation {
+	o := op(process, Result, call, ret)
+	o.Highest = highest
+	return o
+}
+
+func over(process, winner string, highest int32, call, ret int64) Operation {
+	o := result(process, highest, call, ret)
+	o.Over, o.Winner, o.Price = true, winner, highest
+	return o
+}
+
+// in returns o made on auction.
+func in(auction string, o Operation) Operation {
+	o.Auction = auction
+	return o
+}
+
+func create(auction string, terms Terms, call, ret int64) Operation {
+	o := op("auctioneer", Create, call, ret)
+	o.Auction, o.Terms = auction, &terms
+	return o
+}
+
+func failed(o Operation) Operation {
+	o.Outcome, o.Error = "", "rpc error: code = Unavailable"
+	return o
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name         string
+		ops          []Operation
+		linearizable bool
+	}{
+		{"empty", nil, true},
+		{"sequential", []Operation{
+			bid("Alice", 5, "success", 1, 2),
+			result("Bob", 5, 3, 4),
+			bid("Bob", 6, "success", 5, 6),
+			bid("Alice", 6, "fail", 7, 8),
+			over("Alice", "Bob", 6, 9, 10),
+		}, true},
+		{"concurrent bids in either order", []Operation{
+			bid("Alice", 5, "success", 1, 4),
+			bid("Bob", 7, "success", 2, 3),
+			result("Carol", 7, 5, 6),
+		}, true},
+		{"stale result", []Operation{
+			bid("Alice", 5, "success", 1, 2),
+			result("Bob", 0, 3, 4),
+		}, false},
+		{"two winners of the same amount", []Operation{
+			bid("Alice", 2, "success", 1, 2),
+			bid("Bob", 2, "success", 1, 2),
+		}, false},
+		{"failed bid that took effect", []Operation{
+			failed(bid("Alice", 5, "", 1, 2)),
+			result("Bob", 5, 3, 4),
+		}, true},
+		{"failed bid that did not", []Operation{
+			failed(bid("Alice", 5, "", 1, 2)),
+			result("Bob", 0, 3, 4),
+			bid("Bob", 3, "success", 5, 6),
+		}, true},
+		{"failed result", []Operation{
+			bid("Alice", 5, "success", 1, 2),
+			failed(result("Bob", 0, 3, 4)),
+		}, true},
+		{"open after over", []Operation{
+			bid("Alice", 5, "success", 1, 2),
+			over("Bob", "Alice", 5, 3, 4),
+			result("Carol", 5, 5, 6),
+		}, false},
+		{"rejected bid closes the auction", []Operation{
+			bid("Alice", 5, "success", 1, 2),
+			bid("Bob", 6, "fail", 3, 4),
+			over("Carol", "Alice", 5, 5, 6),
+		}, true},
+	}
+	for _, tt := range tests {
+		err := Check(tt.ops)
+		if tt.linearizable && err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		var nl *NonLinearizableError
+		if !tt.linearizable && !errors.As(err, &nl) {
+			t.Errorf("%s: Check = %v, want a NonLinearizableError", tt.name, err)
+		}
+	}
+}
+
+func TestCheckTerms(t *testing.T) {
+	bands := Terms{Mode: "ENGLISH", Opening: 10, Reserve: 50, Increments: []Increment{{From: 0, Step: 1}, {From: 20, Step: 5}}}
+	vickrey := Terms{Mode: "VICKREY", Reserve: 5}
+	tests := []struct {
+		name         string
+		ops          []Operation
+		linearizable bool
+	}{
+		{"auctions are checked apart", []Operation{
+			in("a", bid("Alice", 5, "success", 1, 2)),
+			in("b", bid("Bob", 3, "success", 3, 4)),
+			in("a", result("Carol", 5, 5, 6)),
+			in("b", result("Carol", 3, 5, 6)),
+		}, true},
+		{"bid below the opening price fails", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 5, "fail", 2, 3)),
+			in("a", bid("Bob", 10, "success", 4, 5)),
+		}, true},
+		{"bid below the increment fails", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 20, "success", 2, 3)),
+			in("a", bid("Bob", 24, "fail", 4, 5)),
+			in("a", bid("Bob", 25, "success", 6, 7)),
+		}, true},
+		{"bid below the increment succeeds", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 20, "success", 2, 3)),
+			in("a", bid("Bob", 21, "success", 4, 5)),
+		}, false},
+		{"bid before the auction opens", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 10, "fail", 2, 3)),
+			in("a", bid("Alice", 10, "success", 4, 5)),
+		}, true},
+		{"reserve not met", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 20, "success", 2, 3)),
+			in("a", reserveNotMet("Bob", 50, 4, 5)),
+		}, true},
+		{"reserve met but reported not met", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 60, "success", 2, 3)),
+			in("a", reserveNotMet("Bob", 50, 4, 5)),
+		}, false},
+		{"winner below the reserve", []Operation{
+			create("a", bands, 0, 1),
+			in("a", bid("Alice", 20, "success", 2, 3)),
+			in("a", over("Bob", "Alice", 20, 4, 5)),
+		}, false},
+		{"Vickrey winner pays the second price", []Operation{
+			create("v", vickrey, 0, 1),
+			in("v", bid("Alice", 30, "success", 2, 3)),
+			in("v", bid("Bob", 20, "success", 2, 3)),
+			in("v", sealedOp("Carol", 4, 5)),
+			in("v", pays(over("Carol", "Alice", 30, 6, 7), 20)),
+		}, true},
+		{"Vickrey winner pays their own bid", []Operation{
+			create("v", vickrey, 0, 1),
+			in("v", bid("Alice", 30, "success", 2, 3)),
+			in("v", bid("Bob", 20, "success", 2, 3)),
+			in("v", over("Carol", "Alice", 30, 6, 7)),
+		}, false},
+		{"sealed bid shown before the close", []Operation{
+			create("v", vickrey, 0, 1),
+			in("v", bid("Alice", 30, "success", 2, 3)),
+			in("v", result("Carol", 30, 4, 5)),
+		}, false},
+		{"cancelled", []Operation{
+			in("a", bid("Alice", 5, "success", 1, 2)),
+			in("a", cancelledOp("Bob", 3, 4)),
+			in("a", bid("Carol", 6, "fail", 5, 6)),
+		}, true},
+		{"bid accepted after cancellation", []Operation{
+			in("a", cancelledOp("Bob", 1, 2)),
+			in("a", bid("Carol", 6, "success", 3, 4)),
+		}, false},
+		{"automatic bids are not checked", []Operation{
+			in("a", maxBid("Alice", 50, "success", 1, 2)),
+			in("a", result("Bob", 1, 3, 4)),
+			in("a", result("Bob", 0, 5, 6)),
+		}, true},
+		{"Dutch auctions are not checked", []Operation{
+			create("d", Terms{Mode: "DUTCH", Opening: 100}, 0, 1),
+			in("d", bid("Alice", 90, "success", 2, 3)),
+			in("d", bid("Bob", 80, "success", 4, 5)),
+		}, true},
+	}
+	for _, tt := range tests {
+		err := Check(tt.ops)
+		if tt.linearizable && err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		var nl *NonLinearizableError
+		if !tt.linearizable && !errors.As(err, &nl) {
+			t.Errorf("%s: Check = %v, want a NonLinearizableError", tt.name, err)
+		}
+	}
+}
+
+func reserveNotMet(process string, reserve int32, call, ret int64) Operation {
+	o := op(process, Result, call, ret)
+	o.Over, o.Reserve = true, reserve
+	return o
+}
+
+func pays(o Operation, price int32) Operation {
+	o.Price = price
+	return o
+}
+
+func sealedOp(process string, call, ret int64) Operation {
+	o := op(process, Result, call, ret)
+	o.Sealed = true
+	return o
+}
+
+func cancelledOp(process string, call, ret int64) Operation {
+	o := op(process, Result, call, ret)
+	o.Cancelled = true
+	return o
+}
+
+func maxBid(process string, maxAmount int32, outcome string, call, ret int64) Operation {
+	o := bid(process, 0, outcome, call, ret)
+	o.MaxAmount = maxAmount
+	return o
+}
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		result string
+		want   Operation
+	}{
+		{"7", Operation{Highest: 7}},
+		{"Auction over. Winner: Alice with bid 7", Operation{Over: true, Winner: "Alice", Highest: 7, Price: 7}},
+		{"Auction over. Winner: Alice with bid 7, pays 5", Operation{Over: true, Winner: "Alice", Highest: 7, Price: 5}},
+		{"Auction over. Reserve of 50 not met, no winner", Operation{Over: true, Reserve: 50}},
+		{"Auction cancelled, no winner", Operation{Cancelled: true}},
+		{"Bids are sealed until the auction closes", Operation{Sealed: true}},
+		{"Auction over", Operation{Error: "unrecognized result: Auction over"}},
+	}
+	for _, tt := range tests {
+		var got Operation
+		got.parseResult(tt.result)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseResult(%q) = %+v, want %+v", tt.result, got, tt.want)
+		}
+	}
+}
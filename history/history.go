@@ -0,0 +1,246 @@
+// Package history records the Bid and Result calls clients make against
+// auctions, with the time each was invoked and returned, and checks whether
+// such a history is linearizable: whether each replicated auction behaved
+// as if every call took effect atomically at some instant between its
+// invocation and its response, on a single copy of the auction. It also
+// records the CreateAuction calls that set the terms of the auctions.
+//
+// Histories are written as one JSON operation per line, so the same checker
+// can be used on histories recorded by the in-process test harness and on
+// those recorded by Client.go during a real run.
+package history
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"sync"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+	"MandatoryActivity5/clock"
+
+	"google.golang.org/grpc"
+)
+
+// Kind is the kind of call an Operation records.
+type Kind string
+
+const (
+	Bid    Kind = "bid"
+	Result Kind = "result"
+	Create Kind = "create"
+)
+
+// Operation is one completed or failed call.
+type Operation struct {
+	// Process identifies the caller, typically the bidder.
+	Process string `json:"process"`
+	Kind    Kind   `json:"kind"`
+	// Auction is the ID of the auction called.
+	Auction string `json:"auction,omitempty"`
+
+	// Bidder and Amount are the arguments of a Bid, and MaxAmount the
+	// maximum of an automatic one.
+	Bidder    string `json:"bidder,omitempty"`
+	Amount    int32  `json:"amount,omitempty"`
+	MaxAmount int32  `json:"max_amount,omitempty"`
+	// Outcome is the message returned by a Bid, "success" or "fail".
+	Outcome string `json:"outcome,omitempty"`
+
+	// Highest is the highest bid returned by a Result, and Over and Winner
+	// report whether it said the auction was over and who won. Price is
+	// what the winner pays. Reserve is set instead if the Result said the
+	// highest bid did not meet that reserve, so that nobody won. Cancelled
+	// reports that it said the auction was cancelled, and Sealed that it
+	// said the bids were sealed, without telling the highest.
+	Highest   int32  `json:"highest,omitempty"`
+	Over      bool   `json:"over,omitempty"`
+	Winner    string `json:"winner,omitempty"`
+	Price     int32  `json:"price,omitempty"`
+	Reserve   int32  `json:"reserve,omitempty"`
+	Cancelled bool   `json:"cancelled,omitempty"`
+	Sealed    bool   `json:"sealed,omitempty"`
+
+	// Terms are the terms a Create gave the auction.
+	Terms *Terms `json:"terms,omitempty"`
+
+	// Call and Return are the invocation and response times in Unix
+	// nanoseconds.
+	Call   int64 `json:"call"`
+	Return int64 `json:"return"`
+	// Error is set when the call failed. A failed Bid may or may not have
+	// taken effect.
+	Error string `json:"error,omitempty"`
+}
+
+// Terms are the terms of an auction that the model of Check depends on.
+type Terms struct {
+	// Mode is the name of the auction mode, such as "ENGLISH".
+	Mode       string      `json:"mode,omitempty"`
+	Opening    int32       `json:"opening,omitempty"`
+	Reserve    int32       `json:"reserve,omitempty"`
+	Increments []Increment `json:"increments,omitempty"`
+}
+
+// Increment is the minimum raise over a highest bid of From or more.
+type Increment struct {
+	From int32 `json:"from"`
+	Step int32 `json:"step"`
+}
+
+// Recorder records operations made through it. If it was created with a
+// writer, every operation is also written there as soon as it returns.
+// A nil *Recorder makes the calls without recording them.
+type Recorder struct {
+	mu    sync.Mutex
+	w     io.Writer
+	clock clock.Clock
+	ops   []Operation
+}
+
+// NewRecorder returns a Recorder that times operations with clk and also
+// writes them to w, if w is not nil.
+func NewRecorder(w io.Writer, clk clock.Clock) *Recorder {
+	return &Recorder{w: w, clock: clk}
+}
+
+// Bid calls c.Bid and records it as made by process.
+func (r *Recorder) Bid(ctx context.Context, c pb.AuctionClient, process string, req *pb.BidRequest, opts ...grpc.CallOption) (*pb.BidResponse, error) {
+	if r == nil {
+		return c.Bid(ctx, req, opts...)
+	}
+
+	op := Operation{Process: process, Kind: Bid, Auction: req.Auction, Bidder: req.Bidder, Amount: req.Amount, MaxAmount: req.MaxAmount, Call: r.now()}
+	resp, err := c.Bid(ctx, req, opts...)
+	op.Return = r.now()
+	if err != nil {
+		op.Error = err.Error()
+	} else {
+		op.Outcome = resp.Message
+	}
+	r.record(op)
+	return resp, err
+}
+
+// The results a node returns besides the highest bid of an open auction.
+var (
+	overPattern    = regexp.MustCompile(`^Auction over\. Winner: (.*) with bid (-?\d+)(?:, pays (-?\d+))?$`)
+	reservePattern = regexp.MustCompile(`^Auction over\. Reserve of (-?\d+) not met, no winner$`)
+)
+
+const (
+	cancelledResult = "Auction cancelled, no winner"
+	sealedResult    = "Bids are sealed until the auction closes"
+)
+
+// Result calls c.Result and records it as made by process.
+func (r *Recorder) Result(ctx context.Context, c pb.AuctionClient, process string, req *pb.ResultRequest, opts ...grpc.CallOption) (*pb.ResultResponse, error) {
+	if r == nil {
+		return c.Result(ctx, req, opts...)
+	}
+
+	op := Operation{Process: process, Kind: Result, Auction: req.Auction, Call: r.now()}
+	resp, err := c.Result(ctx, req, opts...)
+	op.Return = r.now()
+	if err != nil {
+		op.Error = err.Error()
+	} else {
+		op.parseResult(resp.Highestbid)
+	}
+	r.record(op)
+	return resp, err
+}
+
+// parseResult sets the fields of a Result from the result it returned.
+func (op *Operation) parseResult(result string) {
+	if m := overPattern.FindStringSubmatch(result); m != nil {
+		highest, _ := strconv.ParseInt(m[2], 10, 32)
+		op.Over, op.Winner, op.Highest, op.Price = true, m[1], int32(highest), int32(highest)
+		if m[3] != "" {
+			price, _ := strconv.ParseInt(m[3], 10, 32)
+			op.Price = int32(price)
+		}
+		return
+	}
+	if m := reservePattern.FindStringSubmatch(result); m != nil {
+		reserve, _ := strconv.ParseInt(m[1], 10, 32)
+		op.Over, op.Reserve = true, int32(reserve)
+		return
+	}
+	switch result {
+	case cancelledResult:
+		op.Cancelled = true
+		return
+	case sealedResult:
+		op.Sealed = true
+		return
+	}
+	highest, err := strconv.ParseInt(result, 10, 32)
+	if err != nil {
+		op.Error = "unrecognized result: " + result
+	}
+	op.Highest = int32(highest)
+}
+
+// CreateAuction calls c.CreateAuction and records it as made by process.
+func (r *Recorder) CreateAuction(ctx context.Context, c pb.AuctionClient, process string, req *pb.CreateAuctionRequest, opts ...grpc.CallOption) (*pb.CreateAuctionResponse, error) {
+	if r == nil {
+		return c.CreateAuction(ctx, req, opts...)
+	}
+
+	terms := &Terms{Mode: req.Mode.String(), Opening: req.Opening, Reserve: req.Reserve}
+	for _, inc := range req.Increments {
+		terms.Increments = append(terms.Increments, Increment{From: inc.From, Step: inc.Step})
+	}
+	op := Operation{Process: process, Kind: Create, Auction: req.Auction, Terms: terms, Call: r.now()}
+	resp, err := c.CreateAuction(ctx, req, opts...)
+	op.Return = r.now()
+	if err != nil {
+		op.Error = err.Error()
+	}
+	r.record(op)
+	return resp, err
+}
+
+func (r *Recorder) record(op Operation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ops = append(r.ops, op)
+	if r.w != nil {
+		line, _ := json.Marshal(op)
+		r.w.Write(append(line, '\n'))
+	}
+}
+
+// Operations returns the operations recorded so far.
+func (r *Recorder) Operations() []Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Operation(nil), r.ops...)
+}
+
+// Read parses a history written by a Recorder.
+func Read(rd io.Reader) ([]Operation, error) {
+	var ops []Operation
+	scanner := bufio.NewScanner(rd)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var op Operation
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, scanner.Err()
+}
+
+func (r *Recorder) now() int64 {
+	return r.clock.Now().UnixNano()
+}
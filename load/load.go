@@ -0,0 +1,176 @@
+// Package load measures the calls a client makes to the nodes while it
+// generates load, and reports throughput, latency percentiles, bid outcomes
+// and errors per node.
+package load
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"path"
+	"regexp"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	pb "MandatoryActivity5/MandatoryActivity5/Node.go"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Stats collects the calls made through its interceptor. It is safe for
+// concurrent use.
+type Stats struct {
+	mu    sync.Mutex
+	start time.Time
+	calls map[key]*calls
+	// outcomes counts bids by outcome: "accepted", or the reason they were
+	// rejected with numbers left out, so that "minimum is 12" and "minimum
+	// is 13" count together.
+	outcomes map[string]int
+}
+
+// key identifies the calls of one method to one node.
+type key struct {
+	node, method string
+}
+
+type calls struct {
+	latencies []time.Duration
+	errors    map[codes.Code]int
+}
+
+// New returns Stats that measure throughput from now.
+func New() *Stats {
+	return &Stats{start: time.Now(), calls: map[key]*calls{}, outcomes: map[string]int{}}
+}
+
+type intendedStartKey struct{}
+
+// WithIntendedStart returns a copy of ctx in which calls are timed from t,
+// when they were meant to be made, if that is earlier than when they are.
+// A load generator that falls behind its schedule because the calls before
+// were slow then reports the wait as latency, instead of leaving it out.
+func WithIntendedStart(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, intendedStartKey{}, t)
+}
+
+// UnaryClientInterceptor times every call, from its intended start if it
+// has one, and records its error or, for a Bid, its outcome.
+func (s *Stats) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		if intended, ok := ctx.Value(intendedStartKey{}).(time.Time); ok && intended.Before(start) {
+			start = intended
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if ctx.Err() == context.Canceled {
+			// The client gave up on the call when it stopped, which says
+			// nothing about the node.
+			return err
+		}
+		s.record(key{node: cc.Target(), method: path.Base(method)}, time.Since(start), reply, err)
+		return err
+	}
+}
+
+var digits = regexp.MustCompile(`\d+`)
+
+func (s *Stats) record(k key, latency time.Duration, reply any, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.calls[k]
+	if c == nil {
+		c = &calls{errors: map[codes.Code]int{}}
+		s.calls[k] = c
+	}
+	c.latencies = append(c.latencies, latency)
+	if err != nil {
+		c.errors[status.Code(err)]++
+		return
+	}
+	if resp, ok := reply.(*pb.BidResponse); ok {
+		if resp.Message == "success" {
+			s.outcomes["accepted"]++
+		} else {
+			s.outcomes["rejected: "+digits.ReplaceAllString(resp.Reason, "N")]++
+		}
+	}
+}
+
+// Report writes the report of the calls so far to w.
+func (s *Stats) Report(w io.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := time.Since(s.start)
+	keys := make([]key, 0, len(s.calls))
+	total, failed := 0, 0
+	for k, c := range s.calls {
+		keys = append(keys, k)
+		total += len(c.latencies)
+		for _, n := range c.errors {
+			failed += n
+		}
+	}
+	slices.SortFunc(keys, func(a, b key) int {
+		return cmp.Or(cmp.Compare(a.method, b.method), cmp.Compare(a.node, b.node))
+	})
+
+	fmt.Fprintf(w, "%d calls in %s, %.1f calls/s, %d failed\n\n", total, elapsed.Round(time.Millisecond), float64(total)/elapsed.Seconds(), failed)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "method\tnode\tcalls\tcalls/s\tp50\tp90\tp99\tmax\terrors\t")
+	for _, k := range keys {
+		c := s.calls[k]
+		lat := slices.Clone(c.latencies)
+		slices.Sort(lat)
+		errs := 0
+		for _, n := range c.errors {
+			errs += n
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.1f\t%s\t%s\t%s\t%s\t%d\t\n", k.method, k.node, len(lat), float64(len(lat))/elapsed.Seconds(),
+			percentile(lat, 0.5), percentile(lat, 0.9), percentile(lat, 0.99), lat[len(lat)-1].Round(time.Microsecond), errs)
+	}
+	tw.Flush()
+
+	if len(s.outcomes) > 0 {
+		fmt.Fprintln(w, "\nbids:")
+		for _, outcome := range sortedByCount(s.outcomes) {
+			fmt.Fprintf(w, "  %6d  %s\n", s.outcomes[outcome], outcome)
+		}
+	}
+
+	if failed > 0 {
+		fmt.Fprintln(w, "\nerrors:")
+		for _, k := range keys {
+			errors := map[string]int{}
+			for code, n := range s.calls[k].errors {
+				errors[code.String()] = n
+			}
+			for _, code := range sortedByCount(errors) {
+				fmt.Fprintf(w, "  %6d  %s %s: %s\n", errors[code], k.method, k.node, code)
+			}
+		}
+	}
+}
+
+// percentile returns the q-th quantile of the sorted latencies.
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	i := int(math.Ceil(q*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)].Round(time.Microsecond)
+}
+
+// sortedByCount returns the keys of counts, most counted first.
+func sortedByCount(counts map[string]int) []string {
+	return slices.SortedFunc(maps.Keys(counts), func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+}
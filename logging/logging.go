@@ -0,0 +1,116 @@
+// Package logging sets up structured JSON logs for the nodes and the
+// client, and gives every gRPC request an ID to find its log lines by.
+//
+// Request IDs travel between processes in gRPC metadata, attached by the
+// interceptor returned from UnaryClientInterceptor and read by the one
+// from UnaryServerInterceptor. A call made while handling a request keeps
+// that request's ID, so the lines of every node a bid reaches share it.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// metadataKey is the gRPC metadata key carrying the request ID.
+const metadataKey = "request-id"
+
+// Config is where and what to log.
+type Config struct {
+	// Path is the log file. It is rotated once it grows past MaxSize
+	// megabytes, keeping MaxBackups old files.
+	Path       string
+	Level      slog.Level
+	MaxSize    int
+	MaxBackups int
+}
+
+// Flags defines the command-line flags for a Config on flag.CommandLine.
+// The path defaults to path, described by usage.
+func Flags(path, usage string) *Config {
+	c := &Config{}
+	flag.StringVar(&c.Path, "log", path, usage)
+	flag.TextVar(&c.Level, "log-level", slog.LevelInfo, "lowest level to log: DEBUG, INFO, WARN or ERROR")
+	flag.IntVar(&c.MaxSize, "log-max-size", 10, "size in megabytes at which the log file is rotated")
+	flag.IntVar(&c.MaxBackups, "log-max-backups", 3, "number of rotated log files to keep")
+	return c
+}
+
+// Open opens the log file and returns a logger writing to it, with attrs
+// on every line. The returned Closer closes the file.
+func (c *Config) Open(attrs ...any) (*slog.Logger, io.Closer) {
+	file := &lumberjack.Logger{Filename: c.Path, MaxSize: c.MaxSize, MaxBackups: c.MaxBackups}
+	handler := slog.NewJSONHandler(file, &slog.HandlerOptions{Level: c.Level})
+	return slog.New(handler).With(attrs...), file
+}
+
+type requestIDKey struct{}
+
+// NewRequestID returns a random request ID.
+func NewRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate request ID: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
+
+// WithRequestID returns ctx with the request ID id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID of ctx, or "" if it has none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Logger returns logger with the request ID of ctx, if it has one.
+func Logger(ctx context.Context, logger *slog.Logger) *slog.Logger {
+	if id := RequestID(ctx); id != "" {
+		return logger.With("request_id", id)
+	}
+	return logger
+}
+
+// UnaryClientInterceptor sends the request ID of the call's context with
+// every call, or a new one if it has none.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		id := RequestID(ctx)
+		if id == "" {
+			id = NewRequestID()
+		}
+		return invoker(metadata.AppendToOutgoingContext(ctx, metadataKey, id), method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryServerInterceptor gives the context of every call the request ID
+// the caller sent, or a new one if it sent none.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if ids := md.Get(metadataKey); len(ids) > 0 {
+				id = ids[0]
+			}
+		}
+		if id == "" {
+			id = NewRequestID()
+		}
+		return handler(WithRequestID(ctx, id), req)
+	}
+}
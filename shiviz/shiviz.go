@@ -0,0 +1,151 @@
+// Package shiviz writes event logs annotated with vector clocks in the
+// format read by ShiViz (https://bestchai.bitbucket.io/shiviz/).
+//
+// Every event is two lines: the process name followed by its vector clock
+// as JSON, then a description of the event. Logs written by several
+// processes can be concatenated and loaded together with the parser
+// regular expression
+//
+//	(?<host>\S*) (?<clock>{.*})\n(?<event>.*)
+//
+// Vector clocks travel between processes in gRPC metadata, attached and
+// read by the interceptors returned from Process.
+package shiviz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataKey is the gRPC metadata key carrying the sender's vector clock.
+const metadataKey = "shiviz-vclock"
+
+// Log is a file that one or more processes write events to.
+type Log struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// Create opens path for appending, creating it if needed.
+func Create(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{file: file}, nil
+}
+
+func (l *Log) Close() error {
+	return l.file.Close()
+}
+
+// Process returns a process named host that writes its events to l. Each
+// goroutine that behaves as an independent participant, such as a bidder,
+// should have its own process.
+func (l *Log) Process(host string) *Process {
+	return &Process{log: l, host: host, clock: map[string]uint64{}}
+}
+
+func (l *Log) write(host string, clock []byte, event string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// The event must stay on a single line for the parser.
+	event = strings.ReplaceAll(event, "\n", " ")
+	fmt.Fprintf(l.file, "%s %s\n%s\n", host, clock, event)
+}
+
+// Process is a participant with its own vector clock.
+type Process struct {
+	log   *Log
+	host  string
+	mu    sync.Mutex
+	clock map[string]uint64
+}
+
+// Event records a local event.
+func (p *Process) Event(event string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tick(event)
+}
+
+// Send records a send event and returns ctx with the process's vector clock
+// attached as outgoing gRPC metadata.
+func (p *Process) Send(ctx context.Context, event string) context.Context {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	clock := p.tick(event)
+	return metadata.AppendToOutgoingContext(ctx, metadataKey, string(clock))
+}
+
+// Receive merges the vector clock found in md, if any, and records a
+// receive event.
+func (p *Process) Receive(md metadata.MD, event string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, v := range md.Get(metadataKey) {
+		var remote map[string]uint64
+		if err := json.Unmarshal([]byte(v), &remote); err != nil {
+			continue
+		}
+		for host, n := range remote {
+			p.clock[host] = max(p.clock[host], n)
+		}
+	}
+	p.tick(event)
+}
+
+// tick advances the process's own entry and writes event. p.mu must be held.
+func (p *Process) tick(event string) []byte {
+	p.clock[p.host]++
+	clock, _ := json.Marshal(p.clock)
+	p.log.write(p.host, clock, event)
+	return clock
+}
+
+// UnaryClientInterceptor records every outgoing call as a send event and
+// its response as a receive event.
+func (p *Process) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = p.Send(ctx, fmt.Sprintf("Send %s to %s: %v", method, cc.Target(), req))
+
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		if err != nil {
+			p.Receive(header, fmt.Sprintf("Receive %s error from %s: %v", method, cc.Target(), err))
+			return err
+		}
+		p.Receive(header, fmt.Sprintf("Receive %s response from %s: %v", method, cc.Target(), reply))
+		return nil
+	}
+}
+
+// UnaryServerInterceptor records every incoming call as a receive event and
+// the response as a send event whose clock is returned in the header.
+func (p *Process) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		p.Receive(md, fmt.Sprintf("Receive %s: %v", info.FullMethod, req))
+
+		resp, err := handler(ctx, req)
+
+		event := fmt.Sprintf("Send %s response: %v", info.FullMethod, resp)
+		if err != nil {
+			event = fmt.Sprintf("Send %s error: %v", info.FullMethod, err)
+		}
+		out, _ := metadata.FromOutgoingContext(p.Send(context.Background(), event))
+		grpc.SetHeader(ctx, out)
+		return resp, err
+	}
+}
@@ -0,0 +1,79 @@
+// Package tracing sets up OpenTelemetry tracing for the nodes and the
+// client.
+//
+// Trace context travels between processes in gRPC metadata, attached and
+// read by the stats handlers returned from DialOption and ServerOption, so
+// one trace follows a request from the client through every node it
+// reaches. Health checks are not traced.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+)
+
+// Start makes service export its spans to dest: "stdout" to print them, or
+// the address of an OTLP collector. It returns a function that flushes the
+// remaining spans and stops exporting. Until Start is called, spans are
+// neither recorded nor exported, but trace context is still propagated.
+func Start(ctx context.Context, service, dest string) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	if dest == "stdout" {
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	} else {
+		exporter, err = otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(dest), otlptracegrpc.WithInsecure())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func init() {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// DialOption returns the option that traces a client's calls.
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck()))))
+}
+
+// ServerOption returns the option that traces a server's calls.
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck()))))
+}
+
+// Inject returns the trace context of ctx as a map, to carry it in a
+// message that is sent later.
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract returns ctx with the trace context carried in m by Inject.
+func Extract(ctx context.Context, m map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(m))
+}